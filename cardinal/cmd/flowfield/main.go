@@ -0,0 +1,295 @@
+// flowfield builds the per layer, per team direction fields of a map file from its masks and bases.
+//
+//	go run ./cmd/flowfield -in data/maps/ProtoType.json
+//
+// Each team gets a Dijkstra distance field over the 8 connected open cells of a layer seeded at the enemy base,
+// every open cell then points down the distance gradient towards that base.
+// Air cells with a clear line to the base point straight at it so flyers cross the map in a straight line.
+// The map file is rewritten in place (or to -out) in the format the shard loads.
+package main
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+
+	"MobaClashRoyal/system"
+)
+
+// 8 connected neighbour offsets {col, row}
+var neighbours = [][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}, {1, 1}, {1, -1}, {-1, 1}, {-1, -1}}
+
+func main() {
+	in := flag.String("in", "", "map file to read the walkable mask and bases from")
+	out := flag.String("out", "", "map file to write (defaults to -in)")
+	flag.Parse()
+
+	if *in == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *out == "" {
+		*out = *in
+	}
+
+	file, err := system.ReadMapFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := system.ValidateMapLayout(file); err != nil {
+		log.Fatalf("invalid map layout %s: %v", *in, err)
+	}
+
+	file.FormatVersion = system.MapFormatVersion
+	file.Fields = make(map[string]map[string][][][]float32, len(system.MapLayers))
+	for _, layer := range system.MapLayers {
+		mask := file.LayerMask(layer)
+		straight := layer == "air"
+		file.Fields[layer] = map[string][][][]float32{
+			"Blue": buildField(file, mask, file.Bases[1], straight), //blue heads to the red base
+			"Red":  buildField(file, mask, file.Bases[0], straight), //red heads to the blue base
+		}
+	}
+
+	if err := system.ValidateMapFile(file); err != nil {
+		log.Fatalf("generated map is invalid: %v", err)
+	}
+
+	raw, err := encodeMapFile(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, raw, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("wrote %s (%s)\n", *out, file.Name)
+}
+
+// builds one flow field over the open cells of mask leading to the target base
+func buildField(file *system.MapFile, mask []string, target []int, straight bool) [][][]float32 {
+	grid := file.Grid
+	cols, rows := grid.Size()
+	walkable := func(col, row int) bool {
+		return col >= 0 && col < cols && row >= 0 && row < rows && mask[row][col] == system.MapCellWalkable
+	}
+
+	dist := dijkstra(cols, rows, walkable, target, grid)
+
+	field := make([][][]float32, rows)
+	unreachable := 0
+	for row := 0; row < rows; row++ {
+		field[row] = make([][]float32, cols)
+		for col := 0; col < cols; col++ {
+			if !walkable(col, row) {
+				continue
+			}
+			if math.IsInf(dist[row][col], 1) {
+				unreachable++
+				field[row][col] = []float32{0, 0}
+				continue
+			}
+			if straight && lineOfSight(col, row, walkable, target, grid) {
+				field[row][col] = straightDirection(col, row, target, grid)
+				continue
+			}
+			field[row][col] = cellDirection(col, row, dist, walkable, target, grid)
+		}
+	}
+	if unreachable > 0 {
+		log.Printf("warning: %d walkable cells in %s cannot reach the base at %v, they get a zero direction", unreachable, file.Name, target)
+	}
+	return field
+}
+
+// distance from every cell to the base cell, diagonal steps may not cut blocked corners
+func dijkstra(cols, rows int, walkable func(col, row int) bool, target []int, grid system.MapGrid) [][]float64 {
+	dist := make([][]float64, rows)
+	for row := range dist {
+		dist[row] = make([]float64, cols)
+		for col := range dist[row] {
+			dist[row][col] = math.Inf(1)
+		}
+	}
+
+	col, row, _ := grid.Cell(target[0], target[1])
+	dist[row][col] = 0
+	queue := &cellQueue{{col: col, row: row}}
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(cellCost)
+		if current.cost > dist[current.row][current.col] {
+			continue
+		}
+		for _, n := range neighbours {
+			nCol, nRow := current.col+n[0], current.row+n[1]
+			if !walkable(nCol, nRow) {
+				continue
+			}
+			step := 1.0
+			if n[0] != 0 && n[1] != 0 {
+				if !walkable(current.col+n[0], current.row) || !walkable(current.col, current.row+n[1]) {
+					continue
+				}
+				step = math.Sqrt2
+			}
+			if cost := current.cost + step; cost < dist[nRow][nCol] {
+				dist[nRow][nCol] = cost
+				heap.Push(queue, cellCost{col: nCol, row: nRow, cost: cost})
+			}
+		}
+	}
+	return dist
+}
+
+// weighted descent over the neighbours closer to the base, the base cell points at the base itself
+func cellDirection(col, row int, dist [][]float64, walkable func(col, row int) bool, target []int, grid system.MapGrid) []float32 {
+	if dist[row][col] == 0 {
+		return straightDirection(col, row, target, grid)
+	}
+
+	var dirX, dirY float64
+	for _, n := range neighbours {
+		nCol, nRow := col+n[0], row+n[1]
+		if !walkable(nCol, nRow) || dist[nRow][nCol] >= dist[row][col] {
+			continue
+		}
+		length := math.Hypot(float64(n[0]), float64(n[1]))
+		slope := (dist[row][col] - dist[nRow][nCol]) / length
+		dirX += float64(n[0]) / length * slope
+		dirY += float64(n[1]) / length * slope
+	}
+	return normalize(dirX, dirY)
+}
+
+// direction from the cell center straight at the base
+func straightDirection(col, row int, target []int, grid system.MapGrid) []float32 {
+	centerX, centerY := cellCenter(col, row, grid)
+	return normalize(float64(target[0])-centerX, float64(target[1])-centerY)
+}
+
+// true if every cell the segment from the cell center to the base passes through is open
+func lineOfSight(col, row int, walkable func(col, row int) bool, target []int, grid system.MapGrid) bool {
+	centerX, centerY := cellCenter(col, row, grid)
+	deltaX, deltaY := float64(target[0])-centerX, float64(target[1])-centerY
+	//sample at a quarter cell so corners are not skipped
+	steps := int(math.Ceil(math.Hypot(deltaX, deltaY) / (float64(grid.Increment) / 4)))
+	for i := 1; i <= steps; i++ {
+		t := float64(i) / float64(steps)
+		x := centerX + deltaX*t
+		y := centerY + deltaY*t
+		sampleCol := int(math.Floor((x - float64(grid.StartX)) / float64(grid.Increment)))
+		sampleRow := int(math.Floor((y - float64(grid.StartY)) / float64(grid.Increment)))
+		if !walkable(sampleCol, sampleRow) {
+			return false
+		}
+	}
+	return true
+}
+
+func cellCenter(col, row int, grid system.MapGrid) (float64, float64) {
+	return float64(grid.StartX+col*grid.Increment) + float64(grid.Increment)/2, float64(grid.StartY+row*grid.Increment) + float64(grid.Increment)/2
+}
+
+// unit vector rounded to the map precision, zero if there is no direction
+func normalize(dirX, dirY float64) []float32 {
+	length := math.Hypot(dirX, dirY)
+	if length == 0 {
+		return []float32{0, 0}
+	}
+	return []float32{round3(dirX / length), round3(dirY / length)}
+}
+
+// direction maps have always been stored to 3 decimals
+func round3(v float64) float32 {
+	return float32(math.Round(v*1000) / 1000)
+}
+
+// writes the map with one grid row per line so map diffs stay readable
+func encodeMapFile(file *system.MapFile) ([]byte, error) {
+	var buf bytes.Buffer
+	header := []struct {
+		key   string
+		value any
+	}{
+		{"formatVersion", file.FormatVersion},
+		{"name", file.Name},
+		{"grid", file.Grid},
+		{"bases", file.Bases},
+		{"towersBlue", file.TowersBlue},
+		{"towersRed", file.TowersRed},
+	}
+
+	buf.WriteString("{\n")
+	for _, h := range header {
+		raw, err := json.Marshal(h.value)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", h.key, err)
+		}
+		fmt.Fprintf(&buf, "  %q: %s,\n", h.key, raw)
+	}
+
+	writeMask(&buf, "walkable", file.Walkable)
+	if file.Flyable != nil {
+		writeMask(&buf, "flyable", file.Flyable)
+	}
+
+	buf.WriteString("  \"fields\": {\n")
+	for i, layer := range system.MapLayers {
+		fmt.Fprintf(&buf, "    %q: {\n", layer)
+		for j, team := range system.MapTeams {
+			rows := make([]string, len(file.Fields[layer][team]))
+			for k, directions := range file.Fields[layer][team] {
+				raw, err := json.Marshal(directions)
+				if err != nil {
+					return nil, fmt.Errorf("error encoding %s %s field: %w", layer, team, err)
+				}
+				rows[k] = "        " + string(raw)
+			}
+			fmt.Fprintf(&buf, "      %q: [\n%s\n      ]%s\n", team, strings.Join(rows, ",\n"), separator(j, len(system.MapTeams)))
+		}
+		fmt.Fprintf(&buf, "    }%s\n", separator(i, len(system.MapLayers)))
+	}
+	buf.WriteString("  }\n}\n")
+	return buf.Bytes(), nil
+}
+
+func writeMask(buf *bytes.Buffer, key string, mask []string) {
+	rows := make([]string, len(mask))
+	for i, row := range mask {
+		rows[i] = fmt.Sprintf("    %q", row)
+	}
+	fmt.Fprintf(buf, "  %q: [\n%s\n  ],\n", key, strings.Join(rows, ",\n"))
+}
+
+// json list separator for element i of n
+func separator(i, n int) string {
+	if i == n-1 {
+		return ""
+	}
+	return ","
+}
+
+type cellCost struct {
+	col, row int
+	cost     float64
+}
+
+// min heap of cells by distance
+type cellQueue []cellCost
+
+func (q cellQueue) Len() int           { return len(q) }
+func (q cellQueue) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q cellQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *cellQueue) Push(x any)        { *q = append(*q, x.(cellCost)) }
+func (q *cellQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,159 @@
+// replay re-simulates a recorded match on a headless world and checks it ends on the recorded state hash.
+//
+//	go run ./cmd/replay -in replay.json
+//
+// The replay file is the match-replay query response of an ended match. The balance file and maps are loaded from
+// world.toml like the shard does, a replay recorded on another balance version is refused.
+// Inputs are sent on the same tick relative to the match start (player2 joining) that the shard accepted them on,
+// and the recorded transaction hashes stand in for the new ones so the match seed comes out the same.
+// Exits 1 when the re-simulated match ends on a different tick or state hash.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+	"MobaClashRoyal/query"
+	"MobaClashRoyal/shard"
+	"MobaClashRoyal/system"
+)
+
+// decoders for the messages a replay records
+var replayMessages = map[string]func(raw json.RawMessage) (any, error){
+	"create-match":          decode[msg.CreateMatchMsg],
+	"create-practice-match": decode[msg.CreatePracticeMatchMsg],
+	"create-unit":           decode[msg.CreateUnitMsg],
+	"surrender":             decode[msg.SurrenderMsg],
+	"remove-all-entities":   decode[msg.RemoveAllEntitiesMsg],
+}
+
+func decode[T any](raw json.RawMessage) (any, error) {
+	var message T
+	err := json.Unmarshal(raw, &message)
+	return message, err
+}
+
+func main() {
+	in := flag.String("in", "", "match-replay response to re-simulate")
+	margin := flag.Uint64("margin", 10, "ticks to keep running past the recorded end before giving up")
+	flag.Parse()
+
+	if *in == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	replay, err := readReplay(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config, err := shard.LoadGameConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := shard.LoadGameData(config); err != nil {
+		log.Fatal(err)
+	}
+	if replay.BalanceVersion != system.BalanceVersion || replay.BalanceChecksum != system.BalanceChecksum {
+		log.Fatalf("replay was recorded on balance %s (%s), loaded balance is %s (%s)",
+			replay.BalanceVersion, replay.BalanceChecksum, system.BalanceVersion, system.BalanceChecksum)
+	}
+
+	//only this tool replays, the recorded admin was allowed to abort the match when it was played
+	rules := system.Rules{Replay: system.NewReplaySession()}
+	for _, input := range replay.Inputs {
+		if input.Message == "remove-all-entities" {
+			rules.AdminPersonas = append(rules.AdminPersonas, input.PersonaTag)
+		}
+	}
+
+	h, err := shard.NewHeadless(rules)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer h.Shutdown()
+
+	length := replay.EndTick - replay.StartTick
+	next := 0
+	for offset := uint64(0); offset <= length+*margin; offset++ {
+		for ; next < len(replay.Inputs) && inputOffset(replay, replay.Inputs[next]) == offset; next++ {
+			input := replay.Inputs[next]
+			if err := sendInput(h, rules.Replay, input); err != nil {
+				log.Fatalf("input %d (%s on tick %d): %v", next, input.Message, input.Tick, err)
+			}
+		}
+		if _, err := h.Tick(); err != nil {
+			log.Fatal(err)
+		}
+
+		replayed, err := query.MatchReplay(h.Context(), &query.MatchReplayRequest{MatchId: replay.MatchId})
+		if err != nil || replayed.EndTick == 0 {
+			continue
+		}
+		if next < len(replay.Inputs) {
+			fmt.Printf("FAIL %s ended on tick %d of %d with %d inputs left\n", replay.MatchId, offset, length, len(replay.Inputs)-next)
+			os.Exit(1)
+		}
+		replayedLength := replayed.EndTick - replayed.StartTick
+		if replayedLength != length || replayed.StateHash != replay.StateHash {
+			fmt.Printf("FAIL %s ended on tick %d with state %s, recorded tick %d with state %s\n",
+				replay.MatchId, replayedLength, replayed.StateHash, length, replay.StateHash)
+			os.Exit(1)
+		}
+		fmt.Printf("OK %s ended on tick %d with state %s\n", replay.MatchId, length, replay.StateHash)
+		return
+	}
+	fmt.Printf("FAIL %s did not end within %d ticks of the recorded end\n", replay.MatchId, *margin)
+	os.Exit(1)
+}
+
+func readReplay(path string) (*comp.Replay, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading replay %s: %w", path, err)
+	}
+	var replay comp.Replay
+	if err := json.Unmarshal(raw, &replay); err != nil {
+		return nil, fmt.Errorf("error decoding replay %s: %w", path, err)
+	}
+	if replay.EndTick == 0 || replay.StateHash == "" {
+		return nil, fmt.Errorf("replay %s has not ended, only ended matches can be re-simulated", path)
+	}
+	if len(replay.Inputs) == 0 {
+		return nil, fmt.Errorf("replay %s is missing the inputs that created the match", path)
+	}
+	return &replay, nil
+}
+
+// ticks after the match start the input was accepted on, player1 creating the match is moved up to the start
+func inputOffset(replay *comp.Replay, input comp.ReplayInput) uint64 {
+	if input.Tick < replay.StartTick {
+		return 0
+	}
+	return input.Tick - replay.StartTick
+}
+
+func sendInput(h *shard.Headless, session *system.ReplaySession, input comp.ReplayInput) error {
+	decoder, ok := replayMessages[input.Message]
+	if !ok {
+		return fmt.Errorf("unknown message %s", input.Message)
+	}
+	message, err := decoder(input.Body)
+	if err != nil {
+		return fmt.Errorf("error decoding body: %w", err)
+	}
+	txHash, err := h.Send(input.Message, input.PersonaTag, message)
+	if err != nil {
+		return err
+	}
+	session.Record(txHash, types.TxHash(input.TxHash))
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+)
+
+func TestReadReplay(t *testing.T) {
+	ended := `{"MatchId":"m","StartTick":10,"EndTick":500,"StateHash":"abc","Inputs":[{"Tick":5,"Message":"create-match"}]}`
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"ended match", ended, ""},
+		{"still running", `{"MatchId":"m","StartTick":10,"Inputs":[{"Tick":5,"Message":"create-match"}]}`, "has not ended"},
+		{"no state hash", `{"MatchId":"m","EndTick":500,"Inputs":[{"Tick":5,"Message":"create-match"}]}`, "has not ended"},
+		{"no inputs", `{"MatchId":"m","EndTick":500,"StateHash":"abc"}`, "missing the inputs"},
+		{"not json", `replay`, "error decoding"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "replay.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			replay, err := readReplay(path)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("readReplay() error = %v", err)
+				}
+				if replay.MatchId != "m" || len(replay.Inputs) != 1 {
+					t.Errorf("readReplay() = %+v", replay)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("readReplay() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := readReplay(filepath.Join(t.TempDir(), "missing.json")); err == nil || !strings.Contains(err.Error(), "error reading") {
+		t.Errorf("readReplay() of a missing file error = %v, want a read error", err)
+	}
+}
+
+func TestInputOffset(t *testing.T) {
+	replay := &comp.Replay{StartTick: 100}
+	tests := []struct {
+		name string
+		tick uint64
+		want uint64
+	}{
+		{"player1 creating the match", 40, 0},
+		{"player2 joining", 100, 0},
+		{"unit placed later", 130, 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inputOffset(replay, comp.ReplayInput{Tick: tt.tick}); got != tt.want {
+				t.Errorf("inputOffset(tick %d) = %d, want %d", tt.tick, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplayMessages(t *testing.T) {
+	raw, err := json.Marshal(msg.CreateUnitMsg{MatchID: "m", UnitType: "Mage", PositionX: 10, PositionY: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := replayMessages["create-unit"](raw)
+	if err != nil {
+		t.Fatalf("decoding create-unit error = %v", err)
+	}
+	unit, ok := decoded.(msg.CreateUnitMsg)
+	if !ok || unit.UnitType != "Mage" || unit.PositionX != 10 || unit.PositionY != 20 {
+		t.Errorf("decoded create-unit = %#v", decoded)
+	}
+	if _, err := replayMessages["surrender"](json.RawMessage(`[]`)); err == nil {
+		t.Errorf("decoding a malformed surrender succeeded, want an error")
+	}
+}
@@ -0,0 +1,360 @@
+// simulate plays a scripted match many times on a headless world and prints outcome statistics for balance work.
+//
+//	go run ./cmd/simulate -scenario data/scenarios/golem_vs_vampire_archer.yaml
+//
+// A scenario names the map, the number of runs and the units each team spawns (see data/scenarios).
+// Scripted units are placed directly, without hands or gold, and spawn positions are jittered per run so runs differ.
+// Each run plays until the match clock or a destroyed base ends it (or the tick limit aborts it), then the
+// match stats are collected for win rate, time to kill the base and per unit damage and kills.
+// The balance file and maps are loaded from world.toml like the shard does.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+	"pkg.world.dev/world-engine/cardinal"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+	"MobaClashRoyal/query"
+	"MobaClashRoyal/shard"
+	"MobaClashRoyal/system"
+)
+
+// personas the simulator plays and aborts matches with
+const (
+	bluePersona  = "sim-blue"
+	redPersona   = "sim-red"
+	adminPersona = "sim-admin"
+)
+
+type Scenario struct {
+	Name   string  `yaml:"name"`
+	Map    string  `yaml:"map"`
+	Runs   int     `yaml:"runs"`
+	Ticks  int     `yaml:"ticks"`  //ticks a run may take before it is aborted, 0 lets the match clock end it
+	Jitter float32 `yaml:"jitter"` //largest random offset added to a spawn position on each axis
+	Seed   int64   `yaml:"seed"`
+	Blue   []Spawn `yaml:"blue"`
+	Red    []Spawn `yaml:"red"`
+}
+
+type Spawn struct {
+	Unit  string  `yaml:"unit"`
+	Tick  int     `yaml:"tick"`  //ticks after the match starts
+	Every int     `yaml:"every"` //spawn again every this many ticks, 0 spawns once
+	X     float32 `yaml:"x"`
+	Y     float32 `yaml:"y"`
+}
+
+// spawn queued for the tick about to run
+type spawnOrder struct {
+	team   string
+	create msg.CreateUnitMsg
+}
+
+// spawns for the next tick, set before the tick and drained by scriptedSpawnSystem
+var pending []spawnOrder
+
+// places the scripted units of the current tick, runs after the game systems
+func scriptedSpawnSystem(world cardinal.WorldContext) error {
+	for _, order := range pending {
+		if err := system.SpawnUnit(world, order.team, &order.create); err != nil {
+			fmt.Printf("error spawning %s for %s: %v \n", order.create.UnitType, order.team, err)
+		}
+	}
+	pending = nil
+	return nil
+}
+
+// totals over every finished run
+type report struct {
+	runs, unfinished int
+	wins             map[string]int //team (empty on a draw): runs won
+	baseKillTicks    map[string]int //team: summed duration of the runs it won by destroying the base
+	baseKills        map[string]int //team: runs it won by destroying the base
+	damageDealt      map[string]map[string]float32
+	damageTaken      map[string]map[string]float32
+	kills            map[string]map[string]int
+}
+
+func main() {
+	scenarioPath := flag.String("scenario", "", "scenario file to play")
+	runs := flag.Int("runs", 0, "number of runs (overrides the scenario)")
+	verbose := flag.Bool("v", false, "print the result of every run")
+	flag.Parse()
+
+	if *scenarioPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	config, err := shard.LoadGameConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := shard.LoadGameData(config); err != nil {
+		log.Fatal(err)
+	}
+
+	scenario, err := readScenario(*scenarioPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *runs > 0 {
+		scenario.Runs = *runs
+	}
+
+	h, err := shard.NewHeadless(system.Rules{AdminPersonas: []string{adminPersona}}, scriptedSpawnSystem)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer h.Shutdown()
+
+	rng := rand.New(rand.NewSource(scenario.Seed))
+	deck := simulationDeck()
+	totals := report{
+		wins:          make(map[string]int),
+		baseKillTicks: make(map[string]int),
+		baseKills:     make(map[string]int),
+		damageDealt:   make(map[string]map[string]float32),
+		damageTaken:   make(map[string]map[string]float32),
+		kills:         make(map[string]map[string]int),
+	}
+	for run := 0; run < scenario.Runs; run++ {
+		history, err := playRun(h, scenario, fmt.Sprintf("sim-%d", run), deck, rng)
+		if err != nil {
+			log.Fatalf("run %d: %v", run, err)
+		}
+		if *verbose {
+			fmt.Printf("run %d: winner %q by %s after %d ticks\n", run, history.WinningTeam, history.Reason, history.DurationTicks)
+		}
+		totals.add(history)
+	}
+	totals.print(scenario)
+}
+
+func readScenario(path string) (*Scenario, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading scenario %s: %w", path, err)
+	}
+	var scenario Scenario
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&scenario); err != nil {
+		return nil, fmt.Errorf("error decoding scenario %s: %w", path, err)
+	}
+
+	if _, ok := system.MapDataRegistry[scenario.Map]; !ok {
+		return nil, fmt.Errorf("scenario %s uses unknown map %q", path, scenario.Map)
+	}
+	if scenario.Runs <= 0 {
+		scenario.Runs = 1
+	}
+	for _, spawn := range append(append([]Spawn(nil), scenario.Blue...), scenario.Red...) {
+		if _, ok := system.UnitRegistry[spawn.Unit]; !ok {
+			return nil, fmt.Errorf("scenario %s spawns unknown unit %q", path, spawn.Unit)
+		}
+		if spawn.Tick < 0 || spawn.Every < 0 {
+			return nil, fmt.Errorf("scenario %s spawns %s on a negative tick", path, spawn.Unit)
+		}
+	}
+	return &scenario, nil
+}
+
+// both players need a valid deck to start a match, scripted spawns don't draw from it
+func simulationDeck() []string {
+	units := make([]string, 0, len(system.UnitRegistry))
+	for name := range system.UnitRegistry {
+		units = append(units, name)
+	}
+	sort.Strings(units)
+	deck := make([]string, 0, system.DeckSize)
+	for i := 0; len(deck) < system.DeckSize && i < len(units)*system.MaxCardCopies; i++ {
+		deck = append(deck, units[i%len(units)])
+	}
+	return deck
+}
+
+// plays one match of the scenario and returns its history once it ended, the match is acknowledged and cleaned up
+func playRun(h *shard.Headless, scenario *Scenario, matchID string, deck []string, rng *rand.Rand) (*comp.MatchHistory, error) {
+	for _, persona := range []string{bluePersona, redPersona} {
+		_, err := h.Send("create-match", persona, msg.CreateMatchMsg{MatchID: matchID, MapName: scenario.Map, Deck: deck})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := h.Tick(); err != nil {
+		return nil, err
+	}
+	if playing, err := system.FindPlayerMatch(h.Context(), bluePersona); err != nil || playing != matchID {
+		return nil, fmt.Errorf("match %s did not start: %v", matchID, err)
+	}
+
+	//jitter is rolled once per spawn entry so repeated waves come from the same spot within a run
+	offsets := make(map[*Spawn][2]float32)
+	for _, spawns := range [][]Spawn{scenario.Blue, scenario.Red} {
+		for i := range spawns {
+			offsets[&spawns[i]] = [2]float32{jitter(rng, scenario.Jitter), jitter(rng, scenario.Jitter)}
+		}
+	}
+
+	for tick := 0; ; tick++ {
+		if scenario.Ticks > 0 && tick == scenario.Ticks {
+			if _, err := h.Send("remove-all-entities", adminPersona, msg.RemoveAllEntitiesMsg{MatchID: matchID}); err != nil {
+				return nil, err
+			}
+		}
+		for _, side := range []struct {
+			team   string
+			spawns []Spawn
+		}{{"Blue", scenario.Blue}, {"Red", scenario.Red}} {
+			for i := range side.spawns {
+				spawn := &side.spawns[i]
+				if !spawnsOn(spawn, tick) {
+					continue
+				}
+				offset := offsets[spawn]
+				pending = append(pending, spawnOrder{team: side.team, create: msg.CreateUnitMsg{
+					MatchID:   matchID,
+					UnitType:  spawn.Unit,
+					PositionX: spawn.X + offset[0],
+					PositionY: spawn.Y + offset[1],
+				}})
+			}
+		}
+		if _, err := h.Tick(); err != nil {
+			return nil, err
+		}
+
+		if _, err := query.MatchResult(h.Context(), &query.MatchResultRequest{MatchId: matchID}); err != nil {
+			continue //still running
+		}
+		history, err := query.MatchHistory(h.Context(), &query.MatchHistoryRequest{PersonaTag: bluePersona, Limit: 1})
+		if err != nil {
+			return nil, err
+		}
+		if len(history.Matches) == 0 || history.Matches[0].MatchId != matchID {
+			return nil, fmt.Errorf("no history recorded for match %s", matchID)
+		}
+
+		//aborted matches are already removed, finished ones wait on both players
+		if history.Matches[0].Reason != "aborted" {
+			for _, persona := range []string{bluePersona, redPersona} {
+				if _, err := h.Send("ack-result", persona, msg.AckResultMsg{MatchID: matchID}); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := h.Tick(); err != nil {
+				return nil, err
+			}
+		}
+		return &history.Matches[0], nil
+	}
+}
+
+// true if the spawn places a unit on tick
+func spawnsOn(spawn *Spawn, tick int) bool {
+	if tick < spawn.Tick {
+		return false
+	}
+	if spawn.Every == 0 {
+		return tick == spawn.Tick
+	}
+	return (tick-spawn.Tick)%spawn.Every == 0
+}
+
+func jitter(rng *rand.Rand, max float32) float32 {
+	if max <= 0 {
+		return 0
+	}
+	return (rng.Float32()*2 - 1) * max
+}
+
+func (r *report) add(history *comp.MatchHistory) {
+	r.runs++
+	if history.Reason == "aborted" {
+		r.unfinished++
+	} else {
+		r.wins[history.WinningTeam]++
+	}
+	if history.Reason == "baseDestroyed" {
+		r.baseKills[history.WinningTeam]++
+		r.baseKillTicks[history.WinningTeam] += history.DurationTicks
+	}
+	for team, units := range history.Stats.DamageDealt {
+		for unit, damage := range units {
+			addFloat(r.damageDealt, team, unit, damage)
+		}
+	}
+	for team, units := range history.Stats.DamageTaken {
+		for unit, damage := range units {
+			addFloat(r.damageTaken, team, unit, damage)
+		}
+	}
+	for team, units := range history.Stats.Kills {
+		for unit, kills := range units {
+			if r.kills[team] == nil {
+				r.kills[team] = make(map[string]int)
+			}
+			r.kills[team][unit] += kills
+		}
+	}
+}
+
+func addFloat(totals map[string]map[string]float32, team, unit string, value float32) {
+	if totals[team] == nil {
+		totals[team] = make(map[string]float32)
+	}
+	totals[team][unit] += value
+}
+
+func (r *report) print(scenario *Scenario) {
+	finished := r.runs - r.unfinished
+	fmt.Printf("%s on %s, %d runs (%d aborted at the tick limit)\n", scenario.Name, scenario.Map, r.runs, r.unfinished)
+	if finished == 0 {
+		return
+	}
+
+	fmt.Printf("\n%-6s %8s %8s %18s\n", "team", "wins", "win %", "avg base kill (s)")
+	for _, team := range system.MapTeams {
+		baseKill := "-"
+		if r.baseKills[team] > 0 {
+			baseKill = fmt.Sprintf("%.1f", float64(r.baseKillTicks[team])/float64(r.baseKills[team])/10)
+		}
+		fmt.Printf("%-6s %8d %7.1f%% %18s\n", team, r.wins[team], percent(r.wins[team], finished), baseKill)
+	}
+	fmt.Printf("%-6s %8d %7.1f%%\n", "draw", r.wins[""], percent(r.wins[""], finished))
+
+	fmt.Printf("\nper run averages\n%-6s %-12s %10s %10s %8s\n", "team", "unit", "dealt", "taken", "kills")
+	for _, team := range system.MapTeams {
+		units := make(map[string]bool)
+		for unit := range r.damageDealt[team] {
+			units[unit] = true
+		}
+		for unit := range r.damageTaken[team] {
+			units[unit] = true
+		}
+		names := make([]string, 0, len(units))
+		for unit := range units {
+			names = append(names, unit)
+		}
+		sort.Strings(names)
+		for _, unit := range names {
+			fmt.Printf("%-6s %-12s %10.1f %10.1f %8.2f\n", team, unit,
+				r.damageDealt[team][unit]/float32(r.runs), r.damageTaken[team][unit]/float32(r.runs), float32(r.kills[team][unit])/float32(r.runs))
+		}
+	}
+}
+
+func percent(n, total int) float64 {
+	return float64(n) / float64(total) * 100
+}
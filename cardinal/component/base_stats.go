@@ -0,0 +1,12 @@
+package component
+
+// stats from the balance file before modifiers, the effective values are kept in Movespeed and Attack
+type BaseStats struct {
+	Movespeed  float32 `json:"Movespeed"`
+	Damage     float32 `json:"Damage"`
+	AttackRate int     `json:"AttackRate"`
+}
+
+func (BaseStats) Name() string {
+	return "BaseStats"
+}
@@ -0,0 +1,14 @@
+package component
+
+// server side player of a practice match, kept on its own entity with the match's MatchId so cleanup removes it
+type BotController struct {
+	Team           string `json:"Team"`
+	Difficulty     string `json:"Difficulty"`
+	NextActionTick int    `json:"NextActionTick"` //match clock (ElapsedTicks) of the next decision
+	PlannedCard    string `json:"PlannedCard"`    //card the bot is saving gold for, empty if none
+	RngState       uint64 `json:"RngState"`       //seeded from the match seed so a replay makes the same choices
+}
+
+func (BotController) Name() string {
+	return "BotController"
+}
@@ -1,7 +1,6 @@
 package component
 
 type CC struct {
-	Stun      int  `json:"Stun"`
 	KnockBack bool `json:"KnockBack"`
 }
 
@@ -0,0 +1,11 @@
+package component
+
+// how much of the damage of each type a unit or structure takes
+type Defense struct {
+	Resistances map[string]float32 `json:"Resistances"` //per damage type, 100 resistance halves the damage
+	DamageTaken float32            `json:"DamageTaken"` //effective damage taken multiplier from modifiers, 1 is unmodified
+}
+
+func (Defense) Name() string {
+	return "Defense"
+}
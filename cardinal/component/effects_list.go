@@ -1,9 +0,0 @@
-package component
-
-type EffectsList struct {
-	EffectsList map[string]int `json:"EffectsList"`
-}
-
-func (EffectsList) Name() string {
-	return "EffectsList"
-}
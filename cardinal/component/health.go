@@ -1,8 +1,16 @@
 package component
 
 type Health struct {
-	CurrentHP float32 `json:"currenthp"`
-	MaxHP     float32 `json:"maxhp"`
+	CurrentHP float32       `json:"currenthp"`
+	MaxHP     float32       `json:"maxhp"`
+	Shields   []ShieldLayer `json:"shields,omitempty"` //absorb damage before CurrentHP, the first to expire is used first
+}
+
+// damage absorb granted by one source, granting from the same source again replaces it
+type ShieldLayer struct {
+	Source         string  `json:"source"`
+	Amount         float32 `json:"amount"`
+	RemainingTicks int     `json:"remainingticks"`
 }
 
 func (Health) Name() string {
@@ -0,0 +1,25 @@
+package component
+
+// bounded, tick stamped log of what happened in a match for clients to page through with a cursor.
+// kept on its own entity with the match's MatchId so cleanup removes it
+type MatchEvents struct {
+	NextSeq uint64       `json:"NextSeq"` //seq the next event gets
+	Events  []MatchEvent `json:"Events"`  //oldest first, the oldest are dropped once the log is full
+}
+
+type MatchEvent struct {
+	Seq       uint64   `json:"Seq"`
+	Tick      uint64   `json:"Tick"`
+	Type      string   `json:"Type"` //spawned, destroyed, damaged, absorbed, spTriggered, spSpawned, towerConverted, towerReady, baseDestroyed, gold
+	UID       int      `json:"UID"`  //entity the event is about, 0 for gold
+	Team      string   `json:"Team"`
+	Name      string   `json:"Name"`                //unit, projectile or structure name, damaged/absorbed: the damage type
+	Value     float32  `json:"Value,omitempty"`     //gold: the players new gold, damaged/absorbed: the damage taken by hp/shields
+	HeldUID   int      `json:"HeldUID,omitempty"`   //spawned: uid the client held the card as, so it can swap it for the unit
+	Structure bool     `json:"Structure,omitempty"` //the event is about a structure, both teams always see those
+	SeenBy    []string `json:"SeenBy,omitempty"`    //teams that saw it happen, it is only published and returned by match-events to them
+}
+
+func (MatchEvents) Name() string {
+	return "MatchEvents"
+}
@@ -0,0 +1,18 @@
+package component
+
+// stats of a finished match, kept as its own entity (no MatchId component) so it outlives match cleanup
+type MatchHistory struct {
+	MatchId       string     `json:"MatchId"`
+	MapName       string     `json:"MapName"`
+	BluePlayer    string     `json:"BluePlayer"`
+	RedPlayer     string     `json:"RedPlayer"`
+	WinningTeam   string     `json:"WinningTeam"`
+	Reason        string     `json:"Reason"`
+	DurationTicks int        `json:"DurationTicks"`
+	EndTick       uint64     `json:"EndTick"`
+	Stats         MatchStats `json:"Stats"`
+}
+
+func (MatchHistory) Name() string {
+	return "MatchHistory"
+}
@@ -0,0 +1,28 @@
+package component
+
+// outcome of a finished match, kept as its own entity (no MatchId component) so it outlives match cleanup
+type MatchResult struct {
+	MatchId     string `json:"MatchId"`
+	BluePlayer  string `json:"BluePlayer"`
+	RedPlayer   string `json:"RedPlayer"`
+	Winner      string `json:"Winner"` //persona tag, empty on a draw or abort
+	Loser       string `json:"Loser"`
+	WinningTeam string `json:"WinningTeam"` //empty on a draw
+	Reason      string `json:"Reason"`      //baseDestroyed, surrender, towers, baseHealth, draw, aborted
+
+	DurationTicks int    `json:"DurationTicks"`
+	EndTick       uint64 `json:"EndTick"`
+
+	TowersDestroyedBlue int     `json:"TowersDestroyedBlue"` //enemy towers destroyed by blue
+	TowersDestroyedRed  int     `json:"TowersDestroyedRed"`
+	BlueBaseHP          float32 `json:"BlueBaseHP"`
+	RedBaseHP           float32 `json:"RedBaseHP"`
+
+	AckBlue         bool   `json:"AckBlue"`
+	AckRed          bool   `json:"AckRed"`
+	AckDeadlineTick uint64 `json:"AckDeadlineTick"` //match entities are cleaned up at this tick even if not acknowledged
+}
+
+func (MatchResult) Name() string {
+	return "MatchResult"
+}
@@ -0,0 +1,10 @@
+package component
+
+type MatchSeed struct {
+	MatchId string `json:"MatchId"`
+	Seed    uint64 `json:"Seed"`
+}
+
+func (MatchSeed) Name() string {
+	return "MatchSeed"
+}
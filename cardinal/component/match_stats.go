@@ -0,0 +1,15 @@
+package component
+
+// running totals for a match, kept on the game state and copied into its MatchHistory when the match ends
+type MatchStats struct {
+	TowersDestroyed map[string]int                `json:"TowersDestroyed"` //team: enemy towers destroyed
+	DamageDealt     map[string]map[string]float32 `json:"DamageDealt"`     //team: unit name: damage its units dealt
+	DamageTaken     map[string]map[string]float32 `json:"DamageTaken"`     //team: unit name: damage its units took
+	Kills           map[string]map[string]int     `json:"Kills"`           //team: unit name: enemies its units finished off
+	CardsPlayed     map[string]map[string]int     `json:"CardsPlayed"`     //team: card: times played
+	GoldSpent       map[string]int                `json:"GoldSpent"`       //team: gold spent on cards
+}
+
+func (MatchStats) Name() string {
+	return "MatchStats"
+}
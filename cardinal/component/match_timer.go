@@ -0,0 +1,11 @@
+package component
+
+type MatchTimer struct {
+	Phase          string `json:"Phase"` //Regulation, Overtime, SuddenDeath
+	PhaseTicksLeft int    `json:"PhaseTicksLeft"`
+	ElapsedTicks   int    `json:"ElapsedTicks"`
+}
+
+func (MatchTimer) Name() string {
+	return "MatchTimer"
+}
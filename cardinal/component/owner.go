@@ -0,0 +1,11 @@
+package component
+
+// unit that fired a projectile or cast a special power entity, damage it deals is credited to this unit
+type Owner struct {
+	UnitName string `json:"UnitName"`
+	Team     string `json:"Team"`
+}
+
+func (Owner) Name() string {
+	return "Owner"
+}
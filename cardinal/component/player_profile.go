@@ -0,0 +1,18 @@
+package component
+
+// a personas record across matches, kept as its own entity (no MatchId component) so it survives match cleanup
+type PlayerProfile struct {
+	PersonaTag  string  `json:"PersonaTag"`
+	Rating      float64 `json:"Rating"` //elo
+	GamesPlayed int     `json:"GamesPlayed"`
+	Wins        int     `json:"Wins"`
+	Losses      int     `json:"Losses"`
+	Draws       int     `json:"Draws"`
+	Streak      int     `json:"Streak"` //positive for consecutive wins, negative for consecutive losses
+	BestStreak  int     `json:"BestStreak"`
+	LastMatchId string  `json:"LastMatchId"`
+}
+
+func (PlayerProfile) Name() string {
+	return "PlayerProfile"
+}
@@ -1,19 +1,17 @@
 package component
 
 type Player1 struct {
-	Nickname    string       `json:"player1"`
-	Hand        []string     `json:"Hand"`
-	Deck        []string     `json:"Deck"`
-	RemovalList map[int]bool `json:"removallist"`
-	Gold        float32      `json:"Gold"`
+	Nickname string   `json:"player1"`
+	Hand     []string `json:"Hand"`
+	Deck     []string `json:"Deck"`
+	Gold     float32  `json:"Gold"`
 }
 
 type Player2 struct {
-	Nickname    string       `json:"player2"`
-	Hand        []string     `json:"Hand"`
-	Deck        []string     `json:"Deck"`
-	RemovalList map[int]bool `json:"removallist"`
-	Gold        float32      `json:"Gold"`
+	Nickname string   `json:"player2"`
+	Hand     []string `json:"Hand"`
+	Deck     []string `json:"Deck"`
+	Gold     float32  `json:"Gold"`
 }
 
 func (Player1) Name() string {
@@ -0,0 +1,15 @@
+package component
+
+// a persona waiting in the matchmaking queue, kept as its own entity until it is paired or leaves
+type QueueEntry struct {
+	PersonaTag string   `json:"PersonaTag"`
+	MapName    string   `json:"MapName"`
+	Deck       []string `json:"Deck"`
+	Rating     float64  `json:"Rating"`   //rating when the persona joined
+	JoinTick   uint64   `json:"JoinTick"` //tick the persona joined, wait time is measured from it
+	TxHash     string   `json:"TxHash"`   //join-queue transaction, seeds the match like a create-match transaction
+}
+
+func (QueueEntry) Name() string {
+	return "QueueEntry"
+}
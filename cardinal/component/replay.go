@@ -0,0 +1,30 @@
+package component
+
+import "encoding/json"
+
+// ordered inputs of a match and what it needs to be re-simulated, kept as its own entity (no MatchId component)
+// so it outlives match cleanup
+type Replay struct {
+	MatchId         string        `json:"MatchId"`
+	MapName         string        `json:"MapName"`
+	BalanceVersion  string        `json:"BalanceVersion"`
+	BalanceChecksum string        `json:"BalanceChecksum"`
+	Seed            uint64        `json:"Seed"`      //final match seed, after player2 joined
+	StartTick       uint64        `json:"StartTick"` //tick player2 joined and the match started
+	EndTick         uint64        `json:"EndTick"`   //tick the match ended, 0 while it is running
+	StateHash       string        `json:"StateHash"` //MatchStateHash when the match ended
+	Inputs          []ReplayInput `json:"Inputs"`
+}
+
+// a message the match accepted
+type ReplayInput struct {
+	Tick       uint64          `json:"Tick"`
+	Message    string          `json:"Message"` //registered message name, create-match, create-unit, surrender, remove-all-entities
+	PersonaTag string          `json:"PersonaTag"`
+	TxHash     string          `json:"TxHash"`
+	Body       json.RawMessage `json:"Body"`
+}
+
+func (Replay) Name() string {
+	return "Replay"
+}
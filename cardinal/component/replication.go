@@ -0,0 +1,28 @@
+package component
+
+// what the game-state-delta query needs to know about a match: when each replicated entity last changed and
+// which were destroyed recently. kept on its own entity with the match's MatchId so cleanup removes it
+type Replication struct {
+	Tick       uint64                   `json:"Tick"`       //last tick the log was updated on
+	OldestTick uint64                   `json:"OldestTick"` //deltas are only complete for clients that saw this tick or later
+	Entities   map[int]ReplicatedEntity `json:"Entities"`   //uid: fingerprint of the entity
+	Removed    []RemovedEntity          `json:"Removed"`    //entities destroyed after OldestTick, oldest first
+}
+
+type ReplicatedEntity struct {
+	Kind        string `json:"Kind"` //unit, structure, projectile or sp
+	Team        string `json:"Team"` //team of the entity, the owners team for projectiles and special powers
+	Fingerprint uint64 `json:"Fingerprint"`
+	ChangedTick uint64 `json:"ChangedTick"` //tick the entity was created or its fingerprint last changed
+}
+
+type RemovedEntity struct {
+	UID  int    `json:"UID"`
+	Kind string `json:"Kind"`
+	Team string `json:"Team"`
+	Tick uint64 `json:"Tick"`
+}
+
+func (Replication) Name() string {
+	return "Replication"
+}
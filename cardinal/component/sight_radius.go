@@ -0,0 +1,10 @@
+package component
+
+// how far a unit or structure reveals enemies to its team
+type SightRadius struct {
+	SightRadius int `json:"SightRadius"`
+}
+
+func (SightRadius) Name() string {
+	return "SightRadius"
+}
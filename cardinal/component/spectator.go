@@ -0,0 +1,47 @@
+package component
+
+// personas watching a match. kept on its own entity with the match's MatchId so cleanup removes it
+type Spectators struct {
+	Personas []string `json:"Personas"` //in the order they joined
+}
+
+// rolling buffer of the frames recorded while a match has spectators, the oldest is the one spectators are shown.
+// kept on its own entity with the match's MatchId so cleanup removes it
+type SpectatorFrames struct {
+	Frames []SpectatorFrame `json:"Frames"` //oldest first
+}
+
+// what spectators of a match see on a tick, shown SpectatorDelayTicks late
+type SpectatorFrame struct {
+	Tick           uint64            `json:"Tick"`
+	Phase          string            `json:"Phase"`
+	TicksRemaining int               `json:"TicksRemaining"`
+	Blue           SpectatedPlayer   `json:"Blue"`
+	Red            SpectatedPlayer   `json:"Red"`
+	Entities       []SpectatedEntity `json:"Entities"` //units, structures, projectiles and special powers in uid order
+}
+
+type SpectatedPlayer struct {
+	Nickname string   `json:"Nickname"`
+	Hand     []string `json:"Hand"`
+	Gold     float32  `json:"Gold"`
+}
+
+type SpectatedEntity struct {
+	UID       int      `json:"UID"`
+	Kind      string   `json:"Kind"` //unit, structure, projectile or sp
+	Name      string   `json:"Name"`
+	Team      string   `json:"Team"` //the owners team for projectiles and special powers
+	CurrentHP float32  `json:"CurrentHP,omitempty"`
+	MaxHP     float32  `json:"MaxHP,omitempty"`
+	State     string   `json:"State,omitempty"` //structures: Default or Converting
+	Position  Position `json:"Position"`
+}
+
+func (Spectators) Name() string {
+	return "Spectators"
+}
+
+func (SpectatorFrames) Name() string {
+	return "SpectatorFrames"
+}
@@ -0,0 +1,17 @@
+package component
+
+// effects active on a unit, behaviour of each lives in the system's effect registry
+type StatusEffects struct {
+	Effects []StatusEffect `json:"Effects"`
+}
+
+type StatusEffect struct {
+	Name           string `json:"Name"`
+	RemainingTicks int    `json:"RemainingTicks"`
+	Stacks         int    `json:"Stacks"`
+	Source         Owner  `json:"Source"` //unit type and team that applied it last, credited with damage it deals
+}
+
+func (StatusEffects) Name() string {
+	return "StatusEffects"
+}
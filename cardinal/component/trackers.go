@@ -1,9 +0,0 @@
-package component
-
-type IntTracker struct {
-	Num int `json:"num"`
-}
-
-func (IntTracker) Name() string {
-	return "IntTracker"
-}
@@ -0,0 +1,12 @@
+package component
+
+// hashes of the view keys the players of a match registered. queries are not signed, so a team's view of the match
+// (enemies in its sight, its hand and deck, its events) is only returned to requests carrying the team's key.
+// kept on its own entity with the match's MatchId so cleanup removes it
+type ViewKeys struct {
+	Teams map[string]string `json:"Teams"` //team: hex sha256 of its view key
+}
+
+func (ViewKeys) Name() string {
+	return "ViewKeys"
+}
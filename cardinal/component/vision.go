@@ -0,0 +1,27 @@
+package component
+
+// what each team of a match can see, the game-state queries leave out enemies a team has no sight of.
+// kept on its own entity with the match's MatchId so cleanup removes it
+type Vision struct {
+	Teams map[string]TeamVision `json:"Teams"` //team doing the seeing: what it sees
+}
+
+type TeamVision struct {
+	Visible     map[int]bool          `json:"Visible"`     //enemy units, projectiles and special powers in sight after the last tick
+	LastKnown   map[int]LastKnownUnit `json:"LastKnown"`   //enemy units as the team last saw them, current for the ones in sight
+	ChangedTick map[int]uint64        `json:"ChangedTick"` //tick an enemy uid last came into sight, left it or was forgotten
+}
+
+// enemy unit as a team last saw it. kept for LastKnownTicks after it leaves sight, or until the team sees it die
+type LastKnownUnit struct {
+	UnitName  string   `json:"UnitName"`
+	Team      string   `json:"Team"`
+	CurrentHP float32  `json:"CurrentHP"`
+	MaxHP     float32  `json:"MaxHP"`
+	Position  Position `json:"Position"`
+	Tick      uint64   `json:"Tick"` //last tick the unit was in sight
+}
+
+func (Vision) Name() string {
+	return "Vision"
+}
@@ -3,8 +3,11 @@ module MobaClashRoyal
 go 1.22.1
 
 require (
+	github.com/pelletier/go-toml/v2 v2.1.0
 	github.com/rs/zerolog v1.32.0
+	gopkg.in/yaml.v3 v3.0.1
 	pkg.world.dev/world-engine/cardinal v1.5.1
+	pkg.world.dev/world-engine/sign v1.0.1-beta
 )
 
 require (
@@ -61,7 +64,6 @@ require (
 	github.com/mattn/go-runewidth v0.0.15 // indirect
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
 	github.com/outcaste-io/ristretto v0.2.3 // indirect
-	github.com/pelletier/go-toml/v2 v2.1.0 // indirect
 	github.com/philhofer/fwd v1.1.2 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/redis/go-redis/v9 v9.1.0 // indirect
@@ -111,8 +113,6 @@ require (
 	google.golang.org/protobuf v1.33.0 // indirect
 	gopkg.in/DataDog/dd-trace-go.v1 v1.63.1 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 	gotest.tools/v3 v3.5.1 // indirect
 	pkg.world.dev/world-engine/rift v1.1.0-beta.0.20240402214846-de1fc179818a // indirect
-	pkg.world.dev/world-engine/sign v1.0.1-beta // indirect
 )
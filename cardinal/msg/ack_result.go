@@ -0,0 +1,9 @@
+package msg
+
+type AckResultMsg struct {
+	MatchID string
+}
+
+type AckResultResult struct {
+	Success bool `json:"success"`
+}
@@ -3,6 +3,7 @@ package msg
 type CreateMatchMsg struct {
 	MatchID string
 	MapName string
+	Deck    []string //cards the player brings, validated against the unit registry
 }
 
 type CreateMatchResult struct {
@@ -2,8 +2,8 @@ package msg
 
 type CreateUnitMsg struct {
 	MatchID string
-	MapName string
-	Team    string
+	MapName string //optional, must match the match map when sent
+	Team    string //optional, the team is derived from the senders persona and must match when sent
 
 	UnitType  string
 	PositionX float32
@@ -0,0 +1,12 @@
+package msg
+
+type CreatePracticeMatchMsg struct {
+	MatchID    string
+	MapName    string
+	Deck       []string //cards the player brings, the bot plays the same deck
+	Difficulty string   //easy, normal or hard
+}
+
+type CreatePracticeMatchResult struct {
+	Success bool `json:"success"`
+}
@@ -0,0 +1,16 @@
+package msg
+
+type JoinQueueMsg struct {
+	MapName string
+	Deck    []string //cards the player brings, validated against the unit registry
+}
+
+type JoinQueueResult struct {
+	Success bool `json:"success"`
+}
+
+type LeaveQueueMsg struct{}
+
+type LeaveQueueResult struct {
+	Success bool `json:"success"`
+}
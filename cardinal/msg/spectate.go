@@ -0,0 +1,18 @@
+package msg
+
+type SpectateMsg struct {
+	MatchID string
+}
+
+type SpectateResult struct {
+	Success    bool   `json:"success"`
+	DelayTicks uint64 `json:"delayTicks"` //how far behind the match the spectator-state query is
+}
+
+type StopSpectatingMsg struct {
+	MatchID string
+}
+
+type StopSpectatingResult struct {
+	Success bool `json:"success"`
+}
@@ -0,0 +1,10 @@
+package msg
+
+type SurrenderMsg struct {
+	MatchID string
+	Team    string //optional, the team is derived from the senders persona and must match when sent
+}
+
+type SurrenderResult struct {
+	Success bool `json:"success"`
+}
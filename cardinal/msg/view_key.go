@@ -0,0 +1,11 @@
+package msg
+
+type RegisterViewKeyMsg struct {
+	MatchID string
+	KeyHash string //hex sha256 of a secret view key (32 random bytes) the client keeps, the key itself is only sent in queries
+}
+
+type RegisterViewKeyResult struct {
+	Success bool   `json:"success"`
+	Team    string `json:"team"` //team the key shows
+}
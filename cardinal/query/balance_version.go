@@ -0,0 +1,22 @@
+package query
+
+import (
+	"MobaClashRoyal/system"
+
+	"pkg.world.dev/world-engine/cardinal"
+)
+
+type BalanceVersionRequest struct{}
+
+type BalanceVersionResponse struct {
+	Version  string `json:"version"`
+	Checksum string `json:"checksum"`
+}
+
+// returns the version and checksum of the balance file the shard loaded so clients can confirm they run the same numbers
+func BalanceVersion(world cardinal.WorldContext, req *BalanceVersionRequest) (*BalanceVersionResponse, error) {
+	return &BalanceVersionResponse{
+		Version:  system.BalanceVersion,
+		Checksum: system.BalanceChecksum,
+	}, nil
+}
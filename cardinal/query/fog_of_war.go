@@ -0,0 +1,112 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+
+	comp "MobaClashRoyal/component"
+)
+
+// what a team of a match sees, nothing of the enemy if the match has no vision
+func teamVisionGS(world cardinal.WorldContext, matchID, team string) (comp.TeamVision, error) {
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == matchID
+	})
+	visionID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.Vision]())).
+		Where(matchFilter).First(world)
+	if err != nil {
+		return comp.TeamVision{}, fmt.Errorf("error searching for vision: %w", err)
+	}
+	if visionID == iterators.BadID {
+		return comp.TeamVision{}, nil
+	}
+
+	vision, err := cardinal.GetComponent[comp.Vision](world, visionID)
+	if err != nil {
+		return comp.TeamVision{}, fmt.Errorf("error retrieving Vision component: %w", err)
+	}
+	return vision.Teams[team], nil
+}
+
+// leaves out what team can't see: enemy units out of sight are shown hidden where the team last saw them, enemy
+// projectiles and special powers out of sight are dropped. structures are always shown.
+// hidden units are only added if the team's view of them changed after since, 0 adds all of them
+func fogOfWarGS(world cardinal.WorldContext, matchID, team string, since uint64, response UnitStateResponse) (UnitStateResponse, error) {
+	vision, err := teamVisionGS(world, matchID, team)
+	if err != nil {
+		return response, err
+	}
+
+	var units []UnitDetails
+	for _, unit := range response.Units {
+		if unit.Team == team || vision.Visible[unit.UID] {
+			units = append(units, unit)
+		}
+	}
+
+	//hidden units in uid order so responses are stable
+	hidden := make([]int, 0, len(vision.LastKnown))
+	for uid := range vision.LastKnown {
+		if !vision.Visible[uid] && (since == 0 || vision.ChangedTick[uid] > since) {
+			hidden = append(hidden, uid)
+		}
+	}
+	sort.Ints(hidden)
+	for _, uid := range hidden {
+		last := vision.LastKnown[uid]
+		units = append(units, UnitDetails{
+			Team:            last.Team,
+			UID:             uid,
+			UnitName:        last.UnitName,
+			CurrentHP:       last.CurrentHP,
+			MaxHP:           last.MaxHP,
+			PositionVectorX: last.Position.PositionVectorX,
+			PositionVectorY: last.Position.PositionVectorY,
+			PositionVectorZ: last.Position.PositionVectorZ,
+			RotationVectorX: last.Position.RotationVectorX,
+			RotationVectorY: last.Position.RotationVectorY,
+			RotationVectorZ: last.Position.RotationVectorZ,
+			EffectList:      []string{},
+			Effects:         []EffectDetails{},
+			Hidden:          true,
+			LastSeenTick:    last.Tick,
+		})
+	}
+	response.Units = units
+
+	var projectiles []ProjectileDetails
+	for _, projectile := range response.Projectiles {
+		if projectile.Team == team || vision.Visible[projectile.UID] {
+			projectiles = append(projectiles, projectile)
+		}
+	}
+	response.Projectiles = projectiles
+
+	var specialPowers []SpDetails
+	for _, sp := range response.SpecialPowers {
+		if sp.Team == team || vision.Visible[sp.UID] {
+			specialPowers = append(specialPowers, sp)
+		}
+	}
+	response.SpecialPowers = specialPowers
+
+	return response, nil
+}
+
+// enemy uids that left the team's view after since without being shown hidden: seen dying, or projectiles and
+// special powers that left sight, in uid order
+func lostFromViewGS(vision comp.TeamVision, since uint64) []int {
+	var lost []int
+	for uid, changed := range vision.ChangedTick {
+		if _, known := vision.LastKnown[uid]; changed > since && !vision.Visible[uid] && !known {
+			lost = append(lost, uid)
+		}
+	}
+	sort.Ints(lost)
+	return lost
+}
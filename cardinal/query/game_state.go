@@ -2,17 +2,20 @@ package query
 
 import (
 	"fmt"
+	"slices"
 
 	"pkg.world.dev/world-engine/cardinal/search/filter"
 	"pkg.world.dev/world-engine/cardinal/types"
 
 	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/system"
 
 	"pkg.world.dev/world-engine/cardinal"
 )
 
 type UnitMatchIdRequest struct {
 	MatchId string
+	ViewKey string //key the player registered with register-view-key, enemies out of its team's sight are left out
 }
 
 type UnitStateResponse struct {
@@ -26,6 +29,7 @@ type UnitDetails struct {
 	Team            string
 	CurrentHP       float32
 	MaxHP           float32
+	Shield          float32 //damage the unit's shields still absorb
 	PositionVectorX float32
 	PositionVectorY float32
 	PositionVectorZ float32
@@ -46,11 +50,22 @@ type UnitDetails struct {
 	ChargedSP     bool
 	Stunned       bool
 	EffectList    []string
+	Effects       []EffectDetails
+
+	Hidden       bool   //enemy out of sight, only what the team saw last and where is filled in
+	LastSeenTick uint64 //tick a hidden unit was last in sight
+}
+
+type EffectDetails struct {
+	Name           string
+	RemainingTicks int
+	Stacks         int
 }
 
 type ProjectileDetails struct {
 	UID             int
 	Name            string
+	Team            string
 	PositionVectorX float32
 	PositionVectorY float32
 	PositionVectorZ float32
@@ -62,6 +77,7 @@ type ProjectileDetails struct {
 type SpDetails struct {
 	UID             int
 	Name            string
+	Team            string
 	PositionVectorX float32
 	PositionVectorY float32
 	PositionVectorZ float32
@@ -73,6 +89,7 @@ type SpDetails struct {
 type StructureDetails struct {
 	UID             int
 	CurrentHP       float32
+	Shield          float32 //damage the structure's shields still absorb
 	StructureName   string
 	Team            string
 	State           string
@@ -81,12 +98,22 @@ type StructureDetails struct {
 	PositionVectorZ float32
 }
 
+// units, structures, projectiles and special powers of a match as the team of the view key sees them
 func GameState(world cardinal.WorldContext, req *UnitMatchIdRequest) (*UnitStateResponse, error) {
+	team, err := system.ViewerTeam(world, req.MatchId, req.ViewKey)
+	if err != nil {
+		return nil, err
+	}
+	return teamGameState(world, req.MatchId, team)
+}
+
+// units, structures, projectiles and special powers of a match as team sees them
+func teamGameState(world cardinal.WorldContext, matchID, team string) (*UnitStateResponse, error) {
 	var response UnitStateResponse
 
 	//filter for entities with matchID
 	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
-		return m.MatchId == req.MatchId
+		return m.MatchId == matchID
 	})
 
 	//get unit state updates
@@ -112,6 +139,12 @@ func GameState(world cardinal.WorldContext, req *UnitMatchIdRequest) (*UnitState
 		return nil, err
 	}
 
+	//hide what the team can't see
+	response, err = fogOfWarGS(world, matchID, team, 0, response)
+	if err != nil {
+		return nil, err
+	}
+
 	return &response, nil
 }
 
@@ -139,6 +172,7 @@ func unitStateGS(world cardinal.WorldContext, matchFilter cardinal.FilterFn, res
 		}
 		unit.CurrentHP = health.CurrentHP
 		unit.MaxHP = health.MaxHP
+		unit.Shield = system.ShieldAmount(health)
 
 		// Fetch Position component
 		position, err := cardinal.GetComponent[comp.Position](world, id)
@@ -194,27 +228,21 @@ func unitStateGS(world cardinal.WorldContext, matchFilter cardinal.FilterFn, res
 		unit.ChargedSP = unitSp.Charged
 		unit.SpRate = unitSp.SpRate
 
-		// Fetch CC component
-		cc, err := cardinal.GetComponent[comp.CC](world, id)
+		// Fetch status effects component
+		effects, err := cardinal.GetComponent[comp.StatusEffects](world, id)
 		if err != nil {
 			return false
 		}
-		if cc.Stun > 0 {
-			unit.Stunned = true
-		}
-
-		// Fetch effect list component
-		effect, err := cardinal.GetComponent[comp.EffectsList](world, id)
-		if err != nil {
-			return false
-		}
-
-		// Pre-allocate slice with the same capacity as the map
-		unit.EffectList = make([]string, 0, len(effect.EffectsList))
-		if effect.EffectsList != nil {
-			for key := range effect.EffectsList {
-				unit.EffectList = append(unit.EffectList, key)
+		unit.Stunned = system.Stunned(effects)
+
+		// one name per effect, independent instances listed once
+		unit.EffectList = []string{}
+		unit.Effects = make([]EffectDetails, 0, len(effects.Effects))
+		for _, effect := range effects.Effects {
+			if !slices.Contains(unit.EffectList, effect.Name) {
+				unit.EffectList = append(unit.EffectList, effect.Name)
 			}
+			unit.Effects = append(unit.Effects, EffectDetails{Name: effect.Name, RemainingTicks: effect.RemainingTicks, Stacks: effect.Stacks})
 		}
 
 		// Append the gathered data to the response
@@ -258,6 +286,7 @@ func structureStateGS(world cardinal.WorldContext, matchFilter cardinal.FilterFn
 			return false
 		}
 		structure.CurrentHP = health.CurrentHP
+		structure.Shield = system.ShieldAmount(health)
 
 		// Fetch Team component
 		name, err := cardinal.GetComponent[comp.UnitName](world, id)
@@ -315,6 +344,13 @@ func projectileStateGS(world cardinal.WorldContext, matchFilter cardinal.FilterF
 		}
 		projectile.Name = name.UnitName
 
+		// Fetch owner component
+		owner, err := cardinal.GetComponent[comp.Owner](world, id)
+		if err != nil {
+			return false
+		}
+		projectile.Team = owner.Team
+
 		// Fetch Position component
 		position, err := cardinal.GetComponent[comp.Position](world, id)
 		if err != nil {
@@ -361,6 +397,13 @@ func SpStateGS(world cardinal.WorldContext, matchFilter cardinal.FilterFn, respo
 		}
 		sp.Name = name.SpName
 
+		// Fetch owner component
+		owner, err := cardinal.GetComponent[comp.Owner](world, id)
+		if err != nil {
+			return false
+		}
+		sp.Team = owner.Team
+
 		// Fetch Position component
 		position, err := cardinal.GetComponent[comp.Position](world, id)
 		if err != nil {
@@ -0,0 +1,136 @@
+package query
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/system"
+)
+
+type GameStateDeltaRequest struct {
+	MatchId   string
+	ViewKey   string //key the player registered with register-view-key, enemies out of its team's sight are left out
+	SinceTick uint64 //Tick of the last response the client applied, 0 asks for a full snapshot
+}
+
+type GameStateDeltaResponse struct {
+	Tick    uint64 //send back as SinceTick on the next poll
+	Full    bool   //true if this is a full snapshot, the client replaces its state instead of patching it
+	Removed []int  //uids destroyed or gone from the team's view since SinceTick, empty on a full snapshot
+	UnitStateResponse
+}
+
+// returns the units, structures, projectiles and special powers created or changed since SinceTick and the uids
+// destroyed since then, as the team of the view key sees them. enemies coming into or leaving sight count as changed.
+// clients that are too far behind for the kept history get a full snapshot
+func GameStateDelta(world cardinal.WorldContext, req *GameStateDeltaRequest) (*GameStateDeltaResponse, error) {
+	team, err := system.ViewerTeam(world, req.MatchId, req.ViewKey)
+	if err != nil {
+		return nil, err
+	}
+
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == req.MatchId
+	})
+	replicationID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.Replication]())).
+		Where(matchFilter).First(world)
+	if err != nil {
+		return nil, fmt.Errorf("error searching for replication log: %w", err)
+	}
+
+	//match has not started replicating yet, the snapshot is whatever exists
+	if replicationID == iterators.BadID {
+		snapshot, err := teamGameState(world, req.MatchId, team)
+		if err != nil {
+			return nil, err
+		}
+		return &GameStateDeltaResponse{Full: true, UnitStateResponse: *snapshot}, nil
+	}
+
+	replication, err := cardinal.GetComponent[comp.Replication](world, replicationID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Replication component: %w", err)
+	}
+
+	response := GameStateDeltaResponse{Tick: replication.Tick}
+	if needsSnapshot(replication, req.SinceTick) {
+		snapshot, err := teamGameState(world, req.MatchId, team)
+		if err != nil {
+			return nil, err
+		}
+		response.Full = true
+		response.UnitStateResponse = *snapshot
+		return &response, nil
+	}
+
+	vision, err := teamVisionGS(world, req.MatchId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := changedSince(replication, req.SinceTick)
+	for uid, changedTick := range vision.ChangedTick {
+		if changedTick > req.SinceTick {
+			changed[uid] = true
+		}
+	}
+	//the team only learns about enemies it saw go
+	for _, removed := range replication.Removed {
+		if removed.Tick > req.SinceTick && removed.Team == team {
+			response.Removed = append(response.Removed, removed.UID)
+		}
+	}
+	response.Removed = append(response.Removed, lostFromViewGS(vision, req.SinceTick)...)
+	if len(changed) == 0 {
+		return &response, nil
+	}
+
+	changedFilter := cardinal.AndFilter(matchFilter, cardinal.ComponentFilter(func(u comp.UID) bool {
+		return changed[u.UID]
+	}))
+	response.UnitStateResponse, err = unitStateGS(world, changedFilter, response.UnitStateResponse)
+	if err != nil {
+		return nil, err
+	}
+	response.UnitStateResponse, err = structureStateGS(world, changedFilter, response.UnitStateResponse)
+	if err != nil {
+		return nil, err
+	}
+	response.UnitStateResponse, err = projectileStateGS(world, changedFilter, response.UnitStateResponse)
+	if err != nil {
+		return nil, err
+	}
+	response.UnitStateResponse, err = SpStateGS(world, changedFilter, response.UnitStateResponse)
+	if err != nil {
+		return nil, err
+	}
+	response.UnitStateResponse, err = fogOfWarGS(world, req.MatchId, team, req.SinceTick, response.UnitStateResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// true if a client that last applied sinceTick can't be sent a delta: it asked for a snapshot, it is from a tick
+// the log never had, or the removals it missed are no longer kept. the team's view only remembers changes for
+// ReplicationHistoryTicks too
+func needsSnapshot(replication *comp.Replication, sinceTick uint64) bool {
+	tooOld := sinceTick < replication.OldestTick || sinceTick+system.ReplicationHistoryTicks < replication.Tick
+	return sinceTick == 0 || tooOld || sinceTick > replication.Tick
+}
+
+// uids of the replicated entities created or changed after sinceTick
+func changedSince(replication *comp.Replication, sinceTick uint64) map[int]bool {
+	changed := make(map[int]bool)
+	for uid, entity := range replication.Entities {
+		if entity.ChangedTick > sinceTick {
+			changed[uid] = true
+		}
+	}
+	return changed
+}
@@ -0,0 +1,64 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/system"
+)
+
+func TestNeedsSnapshot(t *testing.T) {
+	history := system.ReplicationHistoryTicks
+	replication := &comp.Replication{Tick: 500, OldestTick: 300}
+	tests := []struct {
+		name      string
+		sinceTick uint64
+		want      bool
+	}{
+		{"first poll", 0, true},
+		{"up to date", 500, false},
+		{"a few ticks behind", 490, false},
+		{"at the edge of the history", 500 - history, false},
+		{"past the history", 500 - history - 1, true},
+		{"before the oldest kept removal", 299, true},
+		{"from a tick the log has not reached", 501, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := needsSnapshot(replication, tt.sinceTick); got != tt.want {
+				t.Errorf("needsSnapshot(since %d) = %v, want %v", tt.sinceTick, got, tt.want)
+			}
+		})
+	}
+
+	//a young match has removals older than the history window but never dropped them
+	young := &comp.Replication{Tick: 60, OldestTick: 0}
+	if needsSnapshot(young, 5) {
+		t.Errorf("needsSnapshot(since 5) on tick 60 = true, want a delta")
+	}
+}
+
+func TestChangedSince(t *testing.T) {
+	replication := &comp.Replication{Entities: map[int]comp.ReplicatedEntity{
+		1: {ChangedTick: 10},
+		2: {ChangedTick: 20},
+		3: {ChangedTick: 21},
+	}}
+	tests := []struct {
+		name      string
+		sinceTick uint64
+		want      map[int]bool
+	}{
+		{"everything changed since", 9, map[int]bool{1: true, 2: true, 3: true}},
+		{"changes on the applied tick were already sent", 20, map[int]bool{3: true}},
+		{"nothing new", 21, map[int]bool{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := changedSince(replication, tt.sinceTick); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changedSince(%d) = %v, want %v", tt.sinceTick, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package query
+
+import (
+	"fmt"
+	"slices"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/system"
+)
+
+// most events returned when the request does not set a limit, and the largest limit served
+const (
+	defaultMatchEventsLimit = 100
+	maxMatchEventsLimit     = 512
+)
+
+type MatchEventsRequest struct {
+	MatchId string
+	ViewKey string //key the player registered with register-view-key, only events its team may see are returned
+	Cursor  uint64 //NextCursor of the last response, 0 starts from the oldest kept event
+	Limit   int
+}
+
+type MatchEventsResponse struct {
+	Events     []comp.MatchEvent `json:"Events"`
+	NextCursor uint64            //send back as Cursor on the next poll
+	Truncated  bool              //events after Cursor were dropped from the log, resync from game-state before applying these
+}
+
+// returns the events of a match after the cursor in the order they happened. only events the team saw happen are
+// returned, enemy gold and events about enemies out of sight at the time are left out
+func MatchEvents(world cardinal.WorldContext, req *MatchEventsRequest) (*MatchEventsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultMatchEventsLimit
+	}
+	if limit > maxMatchEventsLimit {
+		limit = maxMatchEventsLimit
+	}
+
+	team, err := system.ViewerTeam(world, req.MatchId, req.ViewKey)
+	if err != nil {
+		return nil, err
+	}
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == req.MatchId
+	})
+	logID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.MatchEvents]())).
+		Where(matchFilter).First(world)
+	if err != nil {
+		return nil, fmt.Errorf("error searching for match events: %w", err)
+	}
+	if logID == iterators.BadID {
+		return nil, fmt.Errorf("no event log found for match: %s", req.MatchId)
+	}
+
+	events, err := cardinal.GetComponent[comp.MatchEvents](world, logID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving MatchEvents component: %w", err)
+	}
+
+	response := pageMatchEvents(events.Events, team, req.Cursor, limit)
+	return &response, nil
+}
+
+// up to limit events team saw after cursor, oldest first
+func pageMatchEvents(events []comp.MatchEvent, team string, cursor uint64, limit int) MatchEventsResponse {
+	response := MatchEventsResponse{Events: []comp.MatchEvent{}, NextCursor: cursor}
+	//sequence numbers have no gaps, so a cursor before the oldest kept event means some were missed
+	if len(events) > 0 && cursor+1 < events[0].Seq {
+		response.Truncated = true
+	}
+	for _, event := range events {
+		if event.Seq <= cursor {
+			continue
+		}
+		if len(response.Events) == limit {
+			break
+		}
+		//hidden events are skipped over, the cursor moves past them
+		response.NextCursor = event.Seq
+		if slices.Contains(event.SeenBy, team) {
+			event.SeenBy = nil
+			response.Events = append(response.Events, event)
+		}
+	}
+	return response
+}
@@ -0,0 +1,74 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+// events with seqs from first to last, even seqs seen by both teams and odd seqs only by Blue
+func testMatchEvents(first, last uint64) []comp.MatchEvent {
+	var events []comp.MatchEvent
+	for seq := first; seq <= last; seq++ {
+		event := comp.MatchEvent{Seq: seq, Tick: seq * 10, Type: "spawned", SeenBy: []string{"Blue"}}
+		if seq%2 == 0 {
+			event.SeenBy = []string{"Blue", "Red"}
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func seqs(events []comp.MatchEvent) []uint64 {
+	out := []uint64{}
+	for _, event := range events {
+		out = append(out, event.Seq)
+	}
+	return out
+}
+
+func TestPageMatchEvents(t *testing.T) {
+	tests := []struct {
+		name          string
+		events        []comp.MatchEvent
+		team          string
+		cursor        uint64
+		limit         int
+		wantSeqs      []uint64
+		wantCursor    uint64
+		wantTruncated bool
+	}{
+		{"everything from the start", testMatchEvents(1, 5), "Blue", 0, 10, []uint64{1, 2, 3, 4, 5}, 5, false},
+		{"after the cursor", testMatchEvents(1, 5), "Blue", 3, 10, []uint64{4, 5}, 5, false},
+		{"limit", testMatchEvents(1, 5), "Blue", 0, 2, []uint64{1, 2}, 2, false},
+		{"next page", testMatchEvents(1, 5), "Blue", 2, 2, []uint64{3, 4}, 4, false},
+		{"caught up", testMatchEvents(1, 5), "Blue", 5, 10, []uint64{}, 5, false},
+		{"empty log", nil, "Blue", 0, 10, []uint64{}, 0, false},
+		{"hidden events skipped", testMatchEvents(1, 5), "Red", 0, 10, []uint64{2, 4}, 5, false},
+		{"cursor moves past hidden events at the end", testMatchEvents(1, 5), "Red", 4, 10, []uint64{}, 5, false},
+		{"limit counts shown events", testMatchEvents(1, 6), "Red", 0, 2, []uint64{2, 4}, 4, false},
+		{"cursor before the oldest kept event", testMatchEvents(10, 12), "Blue", 3, 10, []uint64{10, 11, 12}, 12, true},
+		{"cursor right before the oldest kept event", testMatchEvents(10, 12), "Blue", 9, 10, []uint64{10, 11, 12}, 12, false},
+		{"new client on a trimmed log", testMatchEvents(10, 12), "Blue", 0, 10, []uint64{10, 11, 12}, 12, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pageMatchEvents(tt.events, tt.team, tt.cursor, tt.limit)
+			if !reflect.DeepEqual(seqs(got.Events), tt.wantSeqs) {
+				t.Errorf("events = %v, want %v", seqs(got.Events), tt.wantSeqs)
+			}
+			if got.NextCursor != tt.wantCursor {
+				t.Errorf("NextCursor = %d, want %d", got.NextCursor, tt.wantCursor)
+			}
+			if got.Truncated != tt.wantTruncated {
+				t.Errorf("Truncated = %v, want %v", got.Truncated, tt.wantTruncated)
+			}
+			for _, event := range got.Events {
+				if event.SeenBy != nil {
+					t.Errorf("event %d returned with SeenBy %v", event.Seq, event.SeenBy)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// most matches returned when the request does not set a limit, and the largest limit served
+const (
+	defaultMatchHistoryLimit = 20
+	maxMatchHistoryLimit     = 100
+)
+
+type MatchHistoryRequest struct {
+	PersonaTag string
+	Limit      int
+}
+
+type MatchHistoryResponse struct {
+	Matches []comp.MatchHistory `json:"Matches"`
+}
+
+// returns the stats of the finished matches a persona played, newest first
+func MatchHistory(world cardinal.WorldContext, req *MatchHistoryRequest) (*MatchHistoryResponse, error) {
+	playerFilter := cardinal.ComponentFilter(func(m comp.MatchHistory) bool {
+		return m.BluePlayer == req.PersonaTag || m.RedPlayer == req.PersonaTag
+	})
+	response := MatchHistoryResponse{Matches: []comp.MatchHistory{}}
+	err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchHistory]())).
+		Where(playerFilter).
+		Each(world, func(id types.EntityID) bool {
+			history, err := cardinal.GetComponent[comp.MatchHistory](world, id)
+			if err != nil {
+				return false
+			}
+			response.Matches = append(response.Matches, *history)
+			return true
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error searching match history: %w", err)
+	}
+
+	response.Matches = newestMatches(response.Matches, req.Limit)
+	return &response, nil
+}
+
+// the limit newest matches, newest first. a limit of 0 or less serves the default, larger ones are capped
+func newestMatches(matches []comp.MatchHistory, limit int) []comp.MatchHistory {
+	if limit <= 0 {
+		limit = defaultMatchHistoryLimit
+	}
+	if limit > maxMatchHistoryLimit {
+		limit = maxMatchHistoryLimit
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].EndTick != matches[j].EndTick {
+			return matches[i].EndTick > matches[j].EndTick
+		}
+		return matches[i].MatchId < matches[j].MatchId
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func matchIDs(matches []comp.MatchHistory) []string {
+	out := []string{}
+	for _, match := range matches {
+		out = append(out, match.MatchId)
+	}
+	return out
+}
+
+func TestNewestMatches(t *testing.T) {
+	played := []comp.MatchHistory{
+		{MatchId: "b", EndTick: 200},
+		{MatchId: "c", EndTick: 300},
+		{MatchId: "a", EndTick: 100},
+		{MatchId: "d", EndTick: 200},
+	}
+	var many []comp.MatchHistory
+	for i := 0; i < maxMatchHistoryLimit+10; i++ {
+		many = append(many, comp.MatchHistory{MatchId: fmt.Sprintf("m%03d", i), EndTick: uint64(i)})
+	}
+	tests := []struct {
+		name    string
+		matches []comp.MatchHistory
+		limit   int
+		want    []string
+		wantLen int
+	}{
+		{"newest first, same tick by match id", played, 10, []string{"c", "b", "d", "a"}, 4},
+		{"limit keeps the newest", played, 2, []string{"c", "b"}, 2},
+		{"no matches", nil, 5, []string{}, 0},
+		{"zero limit serves the default", many, 0, nil, defaultMatchHistoryLimit},
+		{"negative limit serves the default", many, -1, nil, defaultMatchHistoryLimit},
+		{"limit is capped", many, maxMatchHistoryLimit + 5, nil, maxMatchHistoryLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newestMatches(slices.Clone(tt.matches), tt.limit)
+			if len(got) != tt.wantLen {
+				t.Fatalf("newestMatches(limit %d) returned %d matches, want %d", tt.limit, len(got), tt.wantLen)
+			}
+			if tt.want != nil && !slices.Equal(matchIDs(got), tt.want) {
+				t.Errorf("newestMatches(limit %d) = %v, want %v", tt.limit, matchIDs(got), tt.want)
+			}
+			if len(got) > 0 && tt.want == nil && got[0].EndTick != uint64(len(tt.matches)-1) {
+				t.Errorf("newestMatches(limit %d) starts with tick %d, want the newest", tt.limit, got[0].EndTick)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package query
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+
+	comp "MobaClashRoyal/component"
+)
+
+type MatchReplayRequest struct {
+	MatchId string
+}
+
+// returns the recorded inputs of a match, complete with its end tick and state hash once the match has ended.
+// save the response to a file and feed it to cmd/replay to re-simulate the match
+func MatchReplay(world cardinal.WorldContext, req *MatchReplayRequest) (*comp.Replay, error) {
+	replayFilter := cardinal.ComponentFilter(func(m comp.Replay) bool {
+		return m.MatchId == req.MatchId
+	})
+	replayID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.Replay]())).
+		Where(replayFilter).First(world)
+	if err != nil {
+		return nil, fmt.Errorf("error searching for replay: %w", err)
+	}
+	if replayID == iterators.BadID {
+		return nil, fmt.Errorf("no replay found for match ID: %s", req.MatchId)
+	}
+
+	replay, err := cardinal.GetComponent[comp.Replay](world, replayID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Replay component: %w", err)
+	}
+	return replay, nil
+}
@@ -0,0 +1,37 @@
+package query
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+
+	comp "MobaClashRoyal/component"
+)
+
+type MatchResultRequest struct {
+	MatchId string
+}
+
+// returns the recorded result of a finished match, available until both players acknowledge it and after
+func MatchResult(world cardinal.WorldContext, req *MatchResultRequest) (*comp.MatchResult, error) {
+	resultFilter := cardinal.ComponentFilter(func(m comp.MatchResult) bool {
+		return m.MatchId == req.MatchId
+	})
+	resultID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchResult]())).
+		Where(resultFilter).First(world)
+	if err != nil {
+		return nil, fmt.Errorf("error searching for match result: %w", err)
+	}
+	if resultID == iterators.BadID {
+		return nil, fmt.Errorf("no result found for match ID: %s", req.MatchId)
+	}
+
+	result, err := cardinal.GetComponent[comp.MatchResult](world, resultID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving MatchResult component: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+
+	comp "MobaClashRoyal/component"
+)
+
+type MatchSeedRequest struct {
+	MatchId string
+}
+
+type MatchSeedResponse struct {
+	MatchId string `json:"MatchId"`
+	Seed    string `json:"Seed"` //decimal uint64, a string so javascript clients keep every digit
+}
+
+// returns the seed of a finished match so its shuffles can be replayed and verified.
+// seeds of running matches are not exposed since they reveal the draw order
+func MatchSeed(world cardinal.WorldContext, req *MatchSeedRequest) (*MatchSeedResponse, error) {
+	seedFilter := cardinal.ComponentFilter(func(m comp.MatchSeed) bool {
+		return m.MatchId == req.MatchId
+	})
+	archive, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchSeed]())).
+		Where(seedFilter).First(world)
+	if err != nil {
+		return nil, fmt.Errorf("error searching for match seed: %w", err)
+	}
+	if archive == iterators.BadID {
+		return nil, fmt.Errorf("no finished match found with ID: %s", req.MatchId)
+	}
+
+	seed, err := cardinal.GetComponent[comp.MatchSeed](world, archive)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving MatchSeed component: %w", err)
+	}
+
+	return &MatchSeedResponse{MatchId: seed.MatchId, Seed: strconv.FormatUint(seed.Seed, 10)}, nil
+}
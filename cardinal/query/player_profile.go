@@ -0,0 +1,113 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// leaderboard page size when the request does not set one, and the largest page served
+const (
+	defaultLeaderboardPageSize = 20
+	maxLeaderboardPageSize     = 100
+)
+
+type PlayerProfileRequest struct {
+	PersonaTag string
+}
+
+// returns a personas rating and record, personas without a finished match have no profile yet
+func PlayerProfile(world cardinal.WorldContext, req *PlayerProfileRequest) (*comp.PlayerProfile, error) {
+	profileFilter := cardinal.ComponentFilter(func(m comp.PlayerProfile) bool {
+		return m.PersonaTag == req.PersonaTag
+	})
+	profileID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.PlayerProfile]())).
+		Where(profileFilter).First(world)
+	if err != nil {
+		return nil, fmt.Errorf("error searching for player profile: %w", err)
+	}
+	if profileID == iterators.BadID {
+		return nil, fmt.Errorf("no profile found for persona: %s", req.PersonaTag)
+	}
+
+	profile, err := cardinal.GetComponent[comp.PlayerProfile](world, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving PlayerProfile component: %w", err)
+	}
+	return profile, nil
+}
+
+type LeaderboardRequest struct {
+	Page     int //0 based
+	PageSize int
+}
+
+type LeaderboardEntry struct {
+	Rank int `json:"Rank"`
+	comp.PlayerProfile
+}
+
+type LeaderboardResponse struct {
+	Entries  []LeaderboardEntry `json:"Entries"`
+	Page     int                `json:"Page"`
+	PageSize int                `json:"PageSize"`
+	Total    int                `json:"Total"` //players on the leaderboard
+}
+
+// returns one page of profiles ranked by rating, ties go to the player with more wins then by persona tag
+func Leaderboard(world cardinal.WorldContext, req *LeaderboardRequest) (*LeaderboardResponse, error) {
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultLeaderboardPageSize
+	}
+	if pageSize > maxLeaderboardPageSize {
+		pageSize = maxLeaderboardPageSize
+	}
+	if req.Page < 0 {
+		return nil, fmt.Errorf("page must not be negative: %d", req.Page)
+	}
+
+	var profiles []comp.PlayerProfile
+	var profileErr error
+	err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.PlayerProfile]())).
+		Each(world, func(id types.EntityID) bool {
+			profile, err := cardinal.GetComponent[comp.PlayerProfile](world, id)
+			if err != nil {
+				profileErr = fmt.Errorf("error retrieving PlayerProfile component: %w", err)
+				return false
+			}
+			profiles = append(profiles, *profile)
+			return true
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error searching player profiles: %w", err)
+	}
+	if profileErr != nil {
+		return nil, profileErr
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		if profiles[i].Rating != profiles[j].Rating {
+			return profiles[i].Rating > profiles[j].Rating
+		}
+		if profiles[i].Wins != profiles[j].Wins {
+			return profiles[i].Wins > profiles[j].Wins
+		}
+		return profiles[i].PersonaTag < profiles[j].PersonaTag
+	})
+
+	response := LeaderboardResponse{Entries: []LeaderboardEntry{}, Page: req.Page, PageSize: pageSize, Total: len(profiles)}
+	start := req.Page * pageSize
+	for i := start; i < len(profiles) && i < start+pageSize; i++ {
+		response.Entries = append(response.Entries, LeaderboardEntry{Rank: i + 1, PlayerProfile: profiles[i]})
+	}
+	return &response, nil
+}
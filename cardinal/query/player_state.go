@@ -12,20 +12,32 @@ import (
 
 type PSMatchIdRequest struct {
 	MatchId string
-	Team    string
+	ViewKey string //key the player registered with register-view-key, the hand and deck shown are its team's
 }
 
 type PlayerStateResponse struct {
-	Units []int //removal list
-	Hand  []string
-	Deck  []string
-	Gold  float32
+	Team string
+	Hand []string
+	Deck []string //draw order
+	Gold float32
+
+	OpponentHandSize int //the opponent's cards are never shown, only how many they hold
+	OpponentDeckSize int
+
+	Phase          string //match clock phase: Regulation, Overtime, SuddenDeath
+	TicksRemaining int    //ticks left in the current phase, 0 once the match has ended
+
+	Spectators []string //personas watching the match
 }
 
-// get a list of all units to be removed for a player to maintian replication
+// get the hand, deck and gold of the view key's team, how many cards the opponent holds, the match clock and who is
+// spectating
 func PlayerState(world cardinal.WorldContext, req *PSMatchIdRequest) (*PlayerStateResponse, error) {
-	var response PlayerStateResponse
-	var removeList = []int{}
+	team, err := system.ViewerTeam(world, req.MatchId, req.ViewKey)
+	if err != nil {
+		return nil, err
+	}
+	response := PlayerStateResponse{Team: team}
 
 	//find gameState using matchID
 	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
@@ -33,49 +45,52 @@ func PlayerState(world cardinal.WorldContext, req *PSMatchIdRequest) (*PlayerSta
 	})
 
 	gameState, err := cardinal.NewSearch().Entity(
-		filter.Exact(system.GameStateFilters())).
+		filter.Exact(system.GameStateFilters()...)).
 		Where(matchFilter).First(world)
 
 	if err != nil {
-		return nil, fmt.Errorf("error searching for team (Removal State Query): %w", err)
+		return nil, fmt.Errorf("error searching for team (Player State Query): %w", err)
 	}
 	if gameState == iterators.BadID {
 		return nil, fmt.Errorf("no match found with ID or missing components: %s", req.MatchId)
 	}
 
-	if req.Team == "Blue" {
-		// Get Player1 component
-		player1, err := cardinal.GetComponent[comp.Player1](world, gameState)
-		if err != nil {
-			return nil, fmt.Errorf("error retrieving Player1 component (Removal State Query): %w", err)
-		}
-		//get the remove list component for Player1
-		for key := range player1.RemovalList {
-			removeList = append(removeList, key)
-		}
+	// Get Player components
+	player1, err := cardinal.GetComponent[comp.Player1](world, gameState)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Player1 component (Player State Query): %w", err)
+	}
+	player2, err := cardinal.GetComponent[comp.Player2](world, gameState)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Player2 component (Player State Query): %w", err)
+	}
 
+	if team == "Blue" {
 		response.Hand = player1.Hand
 		response.Deck = player1.Deck
-		//player1 gold
 		response.Gold = player1.Gold
-
+		response.OpponentHandSize = len(player2.Hand)
+		response.OpponentDeckSize = len(player2.Deck)
 	} else {
-		// Get Player2 component
-		player2, err := cardinal.GetComponent[comp.Player2](world, gameState)
-		if err != nil {
-			return nil, fmt.Errorf("error retrieving Player2 component (Removal State Query): %w", err)
-		}
-		//get the remove list component for Player2
-		for key := range player2.RemovalList {
-			removeList = append(removeList, key)
-		}
-
 		response.Hand = player2.Hand
 		response.Deck = player2.Deck
-		//player2 gold
 		response.Gold = player2.Gold
+		response.OpponentHandSize = len(player1.Hand)
+		response.OpponentDeckSize = len(player1.Deck)
+	}
+
+	//match clock
+	timer, err := cardinal.GetComponent[comp.MatchTimer](world, gameState)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving MatchTimer component (Player State Query): %w", err)
+	}
+	response.Phase = timer.Phase
+	response.TicksRemaining = timer.PhaseTicksLeft
+
+	response.Spectators, err = system.SpectatorNames(world, req.MatchId)
+	if err != nil {
+		return nil, fmt.Errorf("(Player State Query): %w", err)
 	}
-	response.Units = removeList
 
 	return &response, nil
 }
@@ -0,0 +1,73 @@
+package query
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/system"
+)
+
+type QueueStatusRequest struct {
+	PersonaTag string
+}
+
+type QueueStatusResponse struct {
+	Queued       bool    `json:"Queued"`
+	MapName      string  `json:"MapName"`
+	Rating       float64 `json:"Rating"`
+	WaitTicks    uint64  `json:"WaitTicks"`
+	RatingWindow float64 `json:"RatingWindow"` //rating difference currently accepted
+	QueueSize    int     `json:"QueueSize"`    //players waiting on the same map
+	MatchId      string  `json:"MatchId"`      //match the persona was paired into, empty while queued
+}
+
+// reports whether a persona is waiting in the matchmaking queue, or the match it has been paired into
+func QueueStatus(world cardinal.WorldContext, req *QueueStatusRequest) (*QueueStatusResponse, error) {
+	var entries []*comp.QueueEntry
+	err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.QueueEntry]())).
+		Each(world, func(id types.EntityID) bool {
+			entry, err := cardinal.GetComponent[comp.QueueEntry](world, id)
+			if err != nil {
+				return false
+			}
+			entries = append(entries, entry)
+			return true
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error searching queue: %w", err)
+	}
+
+	var response QueueStatusResponse
+	for _, entry := range entries {
+		if entry.PersonaTag != req.PersonaTag {
+			continue
+		}
+		tick := world.CurrentTick()
+		response.Queued = true
+		response.MapName = entry.MapName
+		response.Rating = entry.Rating
+		response.WaitTicks = tick - entry.JoinTick
+		response.RatingWindow = system.RatingWindow(entry.JoinTick, tick)
+	}
+
+	if !response.Queued {
+		matchID, err := system.FindPlayerMatch(world, req.PersonaTag)
+		if err != nil {
+			return nil, err
+		}
+		response.MatchId = matchID
+		return &response, nil
+	}
+
+	for _, entry := range entries {
+		if entry.MapName == response.MapName {
+			response.QueueSize++
+		}
+	}
+	return &response, nil
+}
@@ -0,0 +1,56 @@
+package query
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/system"
+)
+
+type SpectatorStateRequest struct {
+	MatchId string
+}
+
+type SpectatorStateResponse struct {
+	DelayTicks uint64               //how far behind the match the frame is
+	Spectators []string             //personas watching the match
+	Frame      *comp.SpectatorFrame //nil until the match has been spectated for DelayTicks
+}
+
+// read only view of a match for spectators: both hands and gold and every entity, DelayTicks behind the match.
+// queries are not signed, so once anyone spectates a match any caller can read this. the delay is the only thing
+// keeping it from feeding a player the enemy's hand, gold and units out of sight
+func SpectatorState(world cardinal.WorldContext, req *SpectatorStateRequest) (*SpectatorStateResponse, error) {
+	spectators, err := system.SpectatorNames(world, req.MatchId)
+	if err != nil {
+		return nil, err
+	}
+	if len(spectators) == 0 {
+		return nil, fmt.Errorf("match %s has no spectators, send spectate first", req.MatchId)
+	}
+	response := SpectatorStateResponse{DelayTicks: system.SpectatorDelayTicks, Spectators: spectators}
+
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == req.MatchId
+	})
+	framesID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.SpectatorFrames]())).
+		Where(matchFilter).First(world)
+	if err != nil {
+		return nil, fmt.Errorf("error searching spectator frames: %w", err)
+	}
+	if framesID == iterators.BadID {
+		return &response, nil
+	}
+
+	frames, err := cardinal.GetComponent[comp.SpectatorFrames](world, framesID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving SpectatorFrames component: %w", err)
+	}
+	response.Frame = system.SpectatorFrameAt(frames, world.CurrentTick())
+	return &response, nil
+}
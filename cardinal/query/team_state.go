@@ -30,7 +30,7 @@ func TeamState(world cardinal.WorldContext, req *MatchIdRequest) (*TeamStateResp
 		return m.MatchId == req.MatchId
 	})
 	gameState, err := cardinal.NewSearch().Entity(
-		filter.Exact(system.GameStateFilters())).
+		filter.Exact(system.GameStateFilters()...)).
 		Where(matchFilter).First(world)
 
 	if err != nil {
@@ -0,0 +1,68 @@
+package shard
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// world.toml is at the repo root, the shard is run either from there (docker) or from cardinal/ (world cardinal start)
+var worldConfigPaths = []string{"world.toml", "../world.toml"}
+
+// game specific settings read from the [game] section of world.toml
+type GameConfig struct {
+	BalanceFile                  string   `toml:"BALANCE_FILE"`
+	MapDir                       string   `toml:"MAP_DIR"`
+	AdminPersonas                []string `toml:"ADMIN_PERSONAS"`
+	DisableSignatureVerification bool     `toml:"DISABLE_SIGNATURE_VERIFICATION"`
+	SpectatorDelayTicks          uint64   `toml:"SPECTATOR_DELAY_TICKS"`
+}
+
+type worldConfig struct {
+	Game GameConfig `toml:"game"`
+}
+
+// LoadGameConfig finds world.toml and reads the [game] section, relative paths are resolved against the directory world.toml lives in
+func LoadGameConfig() (GameConfig, error) {
+	for _, path := range worldConfigPaths {
+		raw, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return GameConfig{}, fmt.Errorf("error reading %s (config.go): %w", path, err)
+		}
+
+		var config worldConfig
+		if err := toml.Unmarshal(raw, &config); err != nil {
+			return GameConfig{}, fmt.Errorf("error parsing %s (config.go): %w", path, err)
+		}
+
+		game := config.Game
+		if game.BalanceFile == "" {
+			return GameConfig{}, fmt.Errorf("BALANCE_FILE missing from [game] section of %s (config.go)", path)
+		}
+		if game.MapDir == "" {
+			return GameConfig{}, fmt.Errorf("MAP_DIR missing from [game] section of %s (config.go)", path)
+		}
+		//without a delay spectators see the match live and can relay it to a player
+		if game.SpectatorDelayTicks == 0 {
+			return GameConfig{}, fmt.Errorf("SPECTATOR_DELAY_TICKS missing or 0 in [game] section of %s (config.go)", path)
+		}
+		game.BalanceFile = resolveConfigPath(path, game.BalanceFile)
+		game.MapDir = resolveConfigPath(path, game.MapDir)
+		return game, nil
+	}
+	return GameConfig{}, fmt.Errorf("world.toml not found in %v (config.go)", worldConfigPaths)
+}
+
+// resolves a path from world.toml against the directory world.toml lives in
+func resolveConfigPath(configPath, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(filepath.Dir(configPath), path)
+}
@@ -0,0 +1,53 @@
+package shard
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadGameConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		toml    string
+		wantErr string
+	}{
+		{"complete", "[game]\nBALANCE_FILE = 'data/balance.json'\nMAP_DIR = 'data/maps'\nSPECTATOR_DELAY_TICKS = 300\n", ""},
+		{"no balance file", "[game]\nMAP_DIR = 'data/maps'\nSPECTATOR_DELAY_TICKS = 300\n", "BALANCE_FILE"},
+		{"no map dir", "[game]\nBALANCE_FILE = 'data/balance.json'\nSPECTATOR_DELAY_TICKS = 300\n", "MAP_DIR"},
+		{"no spectator delay", "[game]\nBALANCE_FILE = 'data/balance.json'\nMAP_DIR = 'data/maps'\n", "SPECTATOR_DELAY_TICKS"},
+		{"zero spectator delay", "[game]\nBALANCE_FILE = 'data/balance.json'\nMAP_DIR = 'data/maps'\nSPECTATOR_DELAY_TICKS = 0\n", "SPECTATOR_DELAY_TICKS"},
+		{"not toml", "[game\n", "error parsing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "world.toml")
+			if err := os.WriteFile(path, []byte(tt.toml), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			paths := worldConfigPaths
+			worldConfigPaths = []string{path}
+			defer func() { worldConfigPaths = paths }()
+
+			config, err := LoadGameConfig()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("LoadGameConfig() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadGameConfig() error = %v", err)
+			}
+			if config.SpectatorDelayTicks != 300 {
+				t.Errorf("SpectatorDelayTicks = %d, want 300", config.SpectatorDelayTicks)
+			}
+			//paths are resolved against the directory of world.toml
+			if config.BalanceFile != filepath.Join(dir, "data/balance.json") || config.MapDir != filepath.Join(dir, "data/maps") {
+				t.Errorf("LoadGameConfig() paths = %s, %s, want them under %s", config.BalanceFile, config.MapDir, dir)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package shard
+
+import (
+	"fmt"
+	"time"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+	"pkg.world.dev/world-engine/sign"
+
+	"MobaClashRoyal/system"
+)
+
+// Headless is an in memory world that only advances when Tick is called. Tools use it to play matches
+// without redis, a clock or signed transactions. it still serves the cardinal http api, so don't run it next to a shard
+type Headless struct {
+	World  *cardinal.World
+	tickCh chan time.Time
+	doneCh chan uint64
+	errCh  chan error
+}
+
+// NewHeadless starts a world with the game registered on mock redis, game data has to be loaded first (LoadGameData).
+// systems run after the game systems every tick, tools use them to change the world directly
+func NewHeadless(rules system.Rules, systems ...cardinal.System) (*Headless, error) {
+	h := &Headless{
+		tickCh: make(chan time.Time),
+		doneCh: make(chan uint64),
+		errCh:  make(chan error, 1),
+	}
+	w, err := cardinal.NewWorld(
+		cardinal.WithMockRedis(),
+		cardinal.WithDisableSignatureVerification(),
+		cardinal.WithTickChannel(h.tickCh),
+		cardinal.WithTickDoneChannel(h.doneCh),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating headless world (headless.go): %w", err)
+	}
+	MustInitWorld(w, rules)
+	if len(systems) > 0 {
+		Must(cardinal.RegisterSystems(w, systems...))
+	}
+	h.World = w
+
+	go func() {
+		h.errCh <- w.StartGame()
+	}()
+	for !w.IsGameRunning() {
+		select {
+		case err := <-h.errCh:
+			return nil, fmt.Errorf("error starting headless world (headless.go): %w", err)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	return h, nil
+}
+
+// Tick runs one tick with every message sent since the last one and returns the tick that ran
+func (h *Headless) Tick() (uint64, error) {
+	select {
+	case h.tickCh <- time.Now():
+	case err := <-h.errCh:
+		return 0, fmt.Errorf("headless world stopped (headless.go): %w", err)
+	}
+	return <-h.doneCh, nil
+}
+
+// Send queues a registered message (create-match, create-unit, ...) from a persona for the next tick
+func (h *Headless) Send(name, personaTag string, message any) (types.TxHash, error) {
+	registered, ok := h.World.GetMessageByFullName("game." + name)
+	if !ok {
+		return "", fmt.Errorf("message %s is not registered (headless.go)", name)
+	}
+	_, txHash := h.World.AddTransaction(registered.ID(), message, &sign.Transaction{PersonaTag: personaTag})
+	return txHash, nil
+}
+
+// Context is a read only world context for calling queries and system helpers between ticks
+func (h *Headless) Context() cardinal.WorldContext {
+	return cardinal.NewReadOnlyWorldContext(h.World)
+}
+
+// Shutdown stops the world
+func (h *Headless) Shutdown() error {
+	return h.World.Shutdown()
+}
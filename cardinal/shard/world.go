@@ -0,0 +1,172 @@
+package shard
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+	"MobaClashRoyal/query"
+	"MobaClashRoyal/system"
+)
+
+// LoadGameData loads the balance file and maps named in the config into the system registries
+func LoadGameData(config GameConfig) error {
+	if err := system.LoadBalance(config.BalanceFile); err != nil {
+		return err
+	}
+	log.Info().Str("version", system.BalanceVersion).Str("checksum", system.BalanceChecksum).Msg("loaded balance file")
+	if err := system.LoadMaps(config.MapDir); err != nil {
+		return err
+	}
+	system.SpectatorDelayTicks = config.SpectatorDelayTicks
+	return nil
+}
+
+// GameRules are the rules a shard's message systems run with, from the [game] section of world.toml
+func GameRules(config GameConfig) system.Rules {
+	return system.Rules{AdminPersonas: config.AdminPersonas}
+}
+
+// MustInitWorld registers all components, messages, queries, and systems. This initialization happens in a helper
+// function so that this can be used by the shard, tools and tests. rules are handed to the message systems that need them
+func MustInitWorld(w *cardinal.World, rules system.Rules) {
+	// Register components
+	// NOTE: You must register your components here for it to be accessible.
+	Must(
+		cardinal.RegisterComponent[component.Attack](w),
+		cardinal.RegisterComponent[component.Class](w),
+		cardinal.RegisterComponent[component.Damage](w),
+		cardinal.RegisterComponent[component.Destroyed](w),
+		cardinal.RegisterComponent[component.DirectionMap](w),
+		cardinal.RegisterComponent[component.Distance](w),
+		cardinal.RegisterComponent[component.MapName](w),
+		cardinal.RegisterComponent[component.MatchId](w),
+		cardinal.RegisterComponent[component.Movespeed](w),
+		cardinal.RegisterComponent[component.Player1](w),
+		cardinal.RegisterComponent[component.Player2](w),
+		cardinal.RegisterComponent[component.Position](w),
+		cardinal.RegisterComponent[component.SpatialCell](w),
+		cardinal.RegisterComponent[component.SpatialHash](w),
+		cardinal.RegisterComponent[component.Sp](w),
+		cardinal.RegisterComponent[component.SpEntity](w),
+		cardinal.RegisterComponent[component.SpName](w),
+		cardinal.RegisterComponent[component.Target](w),
+		cardinal.RegisterComponent[component.Team](w),
+		cardinal.RegisterComponent[component.UID](w),
+		cardinal.RegisterComponent[component.Health](w),
+		cardinal.RegisterComponent[component.UnitName](w),
+		cardinal.RegisterComponent[component.UnitRadius](w),
+		cardinal.RegisterComponent[component.State](w),
+		cardinal.RegisterComponent[component.CenterOffset](w),
+		cardinal.RegisterComponent[component.CC](w),
+		cardinal.RegisterComponent[component.StatusEffects](w),
+		cardinal.RegisterComponent[component.UnitTag](w),
+		cardinal.RegisterComponent[component.StructureTag](w),
+		cardinal.RegisterComponent[component.ProjectileTag](w),
+		cardinal.RegisterComponent[component.MatchSeed](w),
+		cardinal.RegisterComponent[component.MatchTimer](w),
+		cardinal.RegisterComponent[component.MatchResult](w),
+		cardinal.RegisterComponent[component.MatchStats](w),
+		cardinal.RegisterComponent[component.QueueEntry](w),
+		cardinal.RegisterComponent[component.PlayerProfile](w),
+		cardinal.RegisterComponent[component.MatchHistory](w),
+		cardinal.RegisterComponent[component.Owner](w),
+		cardinal.RegisterComponent[component.Replay](w),
+		cardinal.RegisterComponent[component.BotController](w),
+		cardinal.RegisterComponent[component.Replication](w),
+		cardinal.RegisterComponent[component.MatchEvents](w),
+		cardinal.RegisterComponent[component.SightRadius](w),
+		cardinal.RegisterComponent[component.BaseStats](w),
+		cardinal.RegisterComponent[component.Defense](w),
+		cardinal.RegisterComponent[component.Vision](w),
+		cardinal.RegisterComponent[component.Spectators](w),
+		cardinal.RegisterComponent[component.SpectatorFrames](w),
+		cardinal.RegisterComponent[component.ViewKeys](w),
+	)
+
+	// Register messages (user action)
+	// NOTE: You must register your transactions here for it to be executed.
+	Must(
+		cardinal.RegisterMessage[msg.CreateMatchMsg, msg.CreateMatchResult](w, "create-match"),
+		cardinal.RegisterMessage[msg.CreateUnitMsg, msg.CreateUnitResult](w, "create-unit"),
+		cardinal.RegisterMessage[msg.RemoveAllEntitiesMsg, msg.RemoveAllEntitiesResult](w, "remove-all-entities"),
+		cardinal.RegisterMessage[msg.AckResultMsg, msg.AckResultResult](w, "ack-result"),
+		cardinal.RegisterMessage[msg.SurrenderMsg, msg.SurrenderResult](w, "surrender"),
+		cardinal.RegisterMessage[msg.JoinQueueMsg, msg.JoinQueueResult](w, "join-queue"),
+		cardinal.RegisterMessage[msg.LeaveQueueMsg, msg.LeaveQueueResult](w, "leave-queue"),
+		cardinal.RegisterMessage[msg.CreatePracticeMatchMsg, msg.CreatePracticeMatchResult](w, "create-practice-match"),
+		cardinal.RegisterMessage[msg.SpectateMsg, msg.SpectateResult](w, "spectate"),
+		cardinal.RegisterMessage[msg.StopSpectatingMsg, msg.StopSpectatingResult](w, "stop-spectating"),
+		cardinal.RegisterMessage[msg.RegisterViewKeyMsg, msg.RegisterViewKeyResult](w, "register-view-key"),
+	)
+
+	// Register queries
+	// NOTE: You must register your queries here for it to be accessible.
+	Must(
+		cardinal.RegisterQuery[query.MatchIdRequest, query.TeamStateResponse](w, "team-state", query.TeamState),
+		cardinal.RegisterQuery[query.UnitMatchIdRequest, query.UnitStateResponse](w, "game-state", query.GameState),
+		cardinal.RegisterQuery[query.PSMatchIdRequest, query.PlayerStateResponse](w, "player-state", query.PlayerState),
+		cardinal.RegisterQuery[query.BalanceVersionRequest, query.BalanceVersionResponse](w, "balance-version", query.BalanceVersion),
+		cardinal.RegisterQuery[query.MatchSeedRequest, query.MatchSeedResponse](w, "match-seed", query.MatchSeed),
+		cardinal.RegisterQuery[query.MatchResultRequest, component.MatchResult](w, "match-result", query.MatchResult),
+		cardinal.RegisterQuery[query.QueueStatusRequest, query.QueueStatusResponse](w, "queue-status", query.QueueStatus),
+		cardinal.RegisterQuery[query.PlayerProfileRequest, component.PlayerProfile](w, "player-profile", query.PlayerProfile),
+		cardinal.RegisterQuery[query.LeaderboardRequest, query.LeaderboardResponse](w, "leaderboard", query.Leaderboard),
+		cardinal.RegisterQuery[query.MatchHistoryRequest, query.MatchHistoryResponse](w, "match-history", query.MatchHistory),
+		cardinal.RegisterQuery[query.MatchReplayRequest, component.Replay](w, "match-replay", query.MatchReplay),
+		cardinal.RegisterQuery[query.GameStateDeltaRequest, query.GameStateDeltaResponse](w, "game-state-delta", query.GameStateDelta),
+		cardinal.RegisterQuery[query.MatchEventsRequest, query.MatchEventsResponse](w, "match-events", query.MatchEvents),
+		cardinal.RegisterQuery[query.SpectatorStateRequest, query.SpectatorStateResponse](w, "spectator-state", query.SpectatorState),
+	)
+
+	// Each system executes deterministically in the order they are added.
+	// This is a neat feature that can be strategically used for systems that depends on the order of execution.
+	// For example, you may want to run the attack system before the regen system
+	// so that the player's HP is subtracted (and player killed if it reaches 0) before HP is regenerated.
+	Must(cardinal.RegisterSystems(w,
+		rules.RemoveAllEntitiesMsgSystem,
+		system.AckResultSystem,
+		rules.SurrenderSystem,
+		rules.GameStateSpawnerSystem,
+		rules.PracticeMatchSystem,
+		system.JoinQueueSystem,
+		system.LeaveQueueSystem,
+		system.SpectateSystem,
+		system.StopSpectatingSystem,
+		system.RegisterViewKeySystem,
+		system.MatchmakingSystem,
+
+		system.GoldGeneration, //prespawn phase
+		system.TowerConverterSystem,
+		rules.UnitSpawnerSystem,   //spawn phase
+		system.BotSystem,          //practice bots play cards in the spawn phase too
+		system.StatSystem,         //effective stats for this tick
+		system.UnitMovementSystem, //move phase
+		system.ProjectileMovementSystem,
+		system.CombatCheckSystem, //pre attack phase
+		system.AttackPhaseSystem,
+		system.SpUpdater,
+		system.StatusEffectSystem,
+		system.ShieldSystem,
+		system.DestroyerSystem,      //destroy phase
+		system.MatchTimerSystem,     // match clock
+		system.WinCondition,         // game over
+		system.VisionSystem,         // what each team sees for the game-state queries
+		system.SpectatorFrameSystem, // delayed view for spectator-state
+		system.ReplicationSystem,    // changes since last tick for game-state-delta
+	))
+
+	// Must(cardinal.RegisterInitSystems(w,
+	// 	system.SpawnMaps,
+	// ))
+}
+
+func Must(err ...error) {
+	e := errors.Join(err...)
+	if e != nil {
+		log.Fatal().Err(e).Msg("")
+	}
+}
@@ -11,29 +11,23 @@ import (
 	"MobaClashRoyal/msg"
 )
 
-// RemoveAllEntitiesSystem removes all entities associated with a given MatchId when recieve remove_all_entities.go msg
-func RemoveAllEntitiesMsgSystem(world cardinal.WorldContext) error {
+// RemoveAllEntitiesSystem removes all entities associated with a given MatchId when recieve remove_all_entities.go msg from an admin
+func (r Rules) RemoveAllEntitiesMsgSystem(world cardinal.WorldContext) error {
 	return cardinal.EachMessage(world,
 		func(create cardinal.TxData[msg.RemoveAllEntitiesMsg]) (msg.RemoveAllEntitiesResult, error) {
-			// Create a filter to match entities with the specified MatchId.
-			matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
-				return m.MatchId == create.Msg.MatchID
-			})
-			entitySearch := cardinal.NewSearch().Entity(
-				filter.Contains(filter.Component[comp.MatchId]())).
-				Where(matchFilter)
-
-			// for each ID remove entity based on MatchID search
-			err := entitySearch.Each(world, func(id types.EntityID) bool {
-				//remove entity
-				if err := cardinal.Remove(world, id); err != nil {
-					fmt.Println("Error removing entity (all entity remover/RemoveAllEntitiesMsgSystem):", err)
-					return false
-				}
-				return true
-			})
-
+			//players end their own matches with surrender, wiping a match is reserved for admins
+			if !r.isAdmin(create.Tx.PersonaTag) {
+				return msg.RemoveAllEntitiesResult{Success: false}, fmt.Errorf("%w (all entity remover/RemoveAllEntitiesMsgSystem): %s", ErrNotAdmin, create.Tx.PersonaTag)
+			}
+			err := recordReplayInput(world, create.Msg.MatchID, "remove-all-entities", create.Tx.PersonaTag, r.recordedTxHash(create.Hash), create.Msg)
 			if err != nil {
+				return msg.RemoveAllEntitiesResult{Success: false}, fmt.Errorf("(all entity remover/RemoveAllEntitiesMsgSystem): %w", err)
+			}
+			//record the aborted match, keeping its seed for verification, then remove it without waiting on acknowledgements
+			if err := endMatch(world, create.Msg.MatchID, "", "aborted"); err != nil {
+				return msg.RemoveAllEntitiesResult{Success: false}, fmt.Errorf("error ending match (all entity remover/RemoveAllEntitiesMsgSystem): %w", err)
+			}
+			if err := RemoveAllEntitiesSystem(world, create.Msg.MatchID); err != nil {
 				return msg.RemoveAllEntitiesResult{Success: false}, fmt.Errorf("error during entity removal (all entity remover/RemoveAllEntitiesMsgSystem): %w", err)
 			}
 
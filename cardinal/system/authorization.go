@@ -0,0 +1,48 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+// team the persona plays for in the match, the message body is never trusted for this
+func authorizePlayer(world cardinal.WorldContext, gameState types.EntityID, personaTag string) (string, error) {
+	p1, p2, err := getPlayerComponentsGSS(world, gameState)
+	if err != nil {
+		return "", fmt.Errorf("(authorizePlayer): %w", err)
+	}
+	return nicknameTeam(p1.Nickname, p2.Nickname, personaTag)
+}
+
+// team whose player has the persona's nickname
+func nicknameTeam(blueNickname, redNickname, personaTag string) (string, error) {
+	switch personaTag {
+	case blueNickname:
+		return "Blue", nil
+	case redNickname:
+		return "Red", nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrNotInMatch, personaTag)
+}
+
+// team the persona plays for, a team sent by the client has to agree with it
+func authorizeTeam(world cardinal.WorldContext, gameState types.EntityID, personaTag, claimedTeam string) (string, error) {
+	team, err := authorizePlayer(world, gameState, personaTag)
+	if err != nil {
+		return "", err
+	}
+	if err := checkClaimedTeam(personaTag, team, claimedTeam); err != nil {
+		return "", err
+	}
+	return team, nil
+}
+
+// a team sent by the client has to be the one the persona plays for, an empty claim is left to the persona
+func checkClaimedTeam(personaTag, team, claimedTeam string) error {
+	if claimedTeam != "" && claimedTeam != team {
+		return fmt.Errorf("%w: %s plays for %s not %s", ErrWrongTeam, personaTag, team, claimedTeam)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package system
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNicknameTeam(t *testing.T) {
+	tests := []struct {
+		name       string
+		personaTag string
+		want       string
+		wantErr    error
+	}{
+		{"blue player", "alice", "Blue", nil},
+		{"red player", "bob", "Red", nil},
+		{"spectator", "carol", "", ErrNotInMatch},
+		{"nickname case matters", "Alice", "", ErrNotInMatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nicknameTeam("alice", "bob", tt.personaTag)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("nicknameTeam(%q) error = %v, want %v", tt.personaTag, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("nicknameTeam(%q) = %q, want %q", tt.personaTag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckClaimedTeam(t *testing.T) {
+	tests := []struct {
+		name    string
+		team    string
+		claimed string
+		wantErr error
+	}{
+		{"claims own team", "Blue", "Blue", nil},
+		{"claims nothing", "Red", "", nil},
+		{"claims the other team", "Blue", "Red", ErrWrongTeam},
+		{"claims a made up team", "Red", "Green", ErrWrongTeam},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkClaimedTeam("alice", tt.team, tt.claimed); !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkClaimedTeam(%q, %q) = %v, want %v", tt.team, tt.claimed, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsAdmin(t *testing.T) {
+	rules := Rules{AdminPersonas: []string{"ops"}}
+	tests := []struct {
+		name       string
+		rules      Rules
+		personaTag string
+		want       bool
+	}{
+		{"listed admin", rules, "ops", true},
+		{"player", rules, "alice", false},
+		{"no admins configured", Rules{}, "ops", false},
+		{"empty persona", rules, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.isAdmin(tt.personaTag); got != tt.want {
+				t.Errorf("isAdmin(%q) = %v, want %v", tt.personaTag, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package system
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// version string and sha256 checksum of the balance file currently loaded into the registries
+var BalanceVersion string
+var BalanceChecksum string
+
+// on disk layout of the balance file
+type balanceFile struct {
+	Version       string                    `json:"version"`
+	Units         map[string]UnitType       `json:"units"`
+	SpecialPowers map[string]SpType         `json:"specialPowers"`
+	Projectiles   map[string]ProjectileType `json:"projectiles"`
+	Structures    map[string]StructureData  `json:"structures"`
+}
+
+// units that fire projectiles and need an entry in the projectile registry
+var projectileUnits = []string{"ArcherLady", "Mage", "Base", "Tower"}
+
+// structures the game state spawner needs to build a match
+var requiredStructures = []string{"Base", "Tower"}
+
+// unit classes the class switches know how to handle
+var unitClasses = map[string]bool{"melee": true, "range": true, "air": true}
+
+// loads the balance file at path, validates it and replaces the unit, sp, projectile and structure registries
+func LoadBalance(path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading balance file %s (balance_loader.go): %w", path, err)
+	}
+
+	var balance balanceFile
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&balance); err != nil {
+		return fmt.Errorf("error decoding balance file %s (balance_loader.go): %w", path, err)
+	}
+
+	//the registry key is the unit name
+	for name, unit := range balance.Units {
+		unit.Name = name
+		balance.Units[name] = unit
+	}
+
+	if err := validateBalance(&balance); err != nil {
+		return fmt.Errorf("invalid balance file %s (balance_loader.go): %w", path, err)
+	}
+
+	sum := sha256.Sum256(raw)
+
+	UnitRegistry = balance.Units
+	SpRegistry = balance.SpecialPowers
+	ProjectileRegistry = balance.Projectiles
+	StructureDataRegistry = balance.Structures
+	BalanceVersion = balance.Version
+	BalanceChecksum = hex.EncodeToString(sum[:])
+	return nil
+}
+
+// checks the balance data for missing entries and inconsistent fields, reporting every problem found
+func validateBalance(balance *balanceFile) error {
+	var errs []error
+
+	if balance.Version == "" {
+		errs = append(errs, errors.New("version is missing"))
+	}
+	if len(balance.Units) == 0 {
+		errs = append(errs, errors.New("no units defined"))
+	}
+
+	for _, name := range sortedKeys(balance.Units) {
+		unit := balance.Units[name]
+		if !unitClasses[unit.Class] {
+			errs = append(errs, fmt.Errorf("unit %s has unknown class %q", name, unit.Class))
+		}
+		if unit.Health <= 0 {
+			errs = append(errs, fmt.Errorf("unit %s health must be positive", name))
+		}
+		if unit.Damage < 0 {
+			errs = append(errs, fmt.Errorf("unit %s damage must not be negative", name))
+		}
+		errs = append(errs, validateDamageTypes("unit "+name, unit.DamageType, unit.Resistances)...)
+		if unit.AttackRate <= 0 {
+			errs = append(errs, fmt.Errorf("unit %s attackrate must be positive", name))
+		}
+		if unit.DamageFrame < 0 || unit.DamageFrame > unit.AttackRate {
+			errs = append(errs, fmt.Errorf("unit %s damageframe %d must be within attackrate %d", name, unit.DamageFrame, unit.AttackRate))
+		}
+		if unit.Speed <= 0 {
+			errs = append(errs, fmt.Errorf("unit %s speed must be positive", name))
+		}
+		if unit.Cost < 0 {
+			errs = append(errs, fmt.Errorf("unit %s cost must not be negative", name))
+		}
+		if unit.Radius <= 0 {
+			errs = append(errs, fmt.Errorf("unit %s radius must be positive", name))
+		}
+		if unit.AttackRadius > unit.AggroRadius {
+			errs = append(errs, fmt.Errorf("unit %s AttackRadius %d is larger than AggroRadius %d", name, unit.AttackRadius, unit.AggroRadius))
+		}
+		if unit.SightRadius <= 0 {
+			errs = append(errs, fmt.Errorf("unit %s SightRadius must be positive", name))
+		}
+		if unit.MaxSP <= 0 || unit.CurrentSP < 0 || unit.CurrentSP > unit.MaxSP {
+			errs = append(errs, fmt.Errorf("unit %s sp must satisfy 0 <= currentsp <= maxsp and maxsp > 0", name))
+		}
+		errs = append(errs, validateAuras("unit "+name, unit.Auras)...)
+
+		sp, ok := balance.SpecialPowers[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unit %s has no specialPowers entry", name))
+			continue
+		}
+		if sp.AttackRate <= 0 {
+			errs = append(errs, fmt.Errorf("sp %s attackrate must be positive", name))
+		}
+		if sp.DamageFrame < 0 || sp.DamageFrame > sp.DamageEndFrame || sp.DamageEndFrame > sp.AttackRate {
+			errs = append(errs, fmt.Errorf("sp %s frames must satisfy 0 <= damageframe <= damageendframe <= attackrate", name))
+		}
+	}
+
+	for _, name := range sortedKeys(balance.SpecialPowers) {
+		if _, ok := balance.Units[name]; !ok {
+			errs = append(errs, fmt.Errorf("specialPowers entry %s has no matching unit", name))
+		}
+	}
+
+	for _, name := range requiredStructures {
+		if _, ok := balance.Structures[name]; !ok {
+			errs = append(errs, fmt.Errorf("structure %s is missing", name))
+		}
+	}
+	for _, name := range sortedKeys(balance.Structures) {
+		structure := balance.Structures[name]
+		if structure.Class != "structure" {
+			errs = append(errs, fmt.Errorf("structure %s must have class \"structure\"", name))
+		}
+		if structure.Health <= 0 {
+			errs = append(errs, fmt.Errorf("structure %s health must be positive", name))
+		}
+		if structure.AttackRate <= 0 || structure.DamageFrame < 0 || structure.DamageFrame > structure.AttackRate {
+			errs = append(errs, fmt.Errorf("structure %s attackframe must be within a positive attackrate", name))
+		}
+		if structure.SightRadius <= 0 {
+			errs = append(errs, fmt.Errorf("structure %s SightRadius must be positive", name))
+		}
+		errs = append(errs, validateDamageTypes("structure "+name, structure.DamageType, structure.Resistances)...)
+		errs = append(errs, validateAuras("structure "+name, structure.Auras)...)
+	}
+
+	for _, name := range projectileUnits {
+		if _, ok := balance.Projectiles[name]; !ok {
+			errs = append(errs, fmt.Errorf("projectile for %s is missing", name))
+		}
+	}
+	for _, name := range sortedKeys(balance.Projectiles) {
+		projectile := balance.Projectiles[name]
+		if projectile.Name == "" {
+			errs = append(errs, fmt.Errorf("projectile for %s has no name", name))
+		}
+		if projectile.Speed <= 0 {
+			errs = append(errs, fmt.Errorf("projectile for %s speed must be positive", name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checks the damage type and resistances of a unit or structure
+func validateDamageTypes(owner, damageType string, resistances map[string]float32) []error {
+	var errs []error
+	if !damageTypes[damageType] {
+		errs = append(errs, fmt.Errorf("%s has unknown damagetype %q", owner, damageType))
+	}
+	for _, resisted := range sortedKeys(resistances) {
+		if !damageTypes[resisted] || resisted == DamageTrue {
+			errs = append(errs, fmt.Errorf("%s has a resistance to unknown damage type %q", owner, resisted))
+		}
+		if resistances[resisted] < 0 {
+			errs = append(errs, fmt.Errorf("%s resistance to %s must not be negative", owner, resisted))
+		}
+	}
+	return errs
+}
+
+// checks the auras of a unit or structure
+func validateAuras(owner string, auras []Aura) []error {
+	var errs []error
+	for i, aura := range auras {
+		if aura.Radius <= 0 {
+			errs = append(errs, fmt.Errorf("%s aura %d radius must be positive", owner, i))
+		}
+		if !statNames[aura.Stat] {
+			errs = append(errs, fmt.Errorf("%s aura %d has unknown stat %q", owner, i, aura.Stat))
+		}
+		if aura.Mult < 0 {
+			errs = append(errs, fmt.Errorf("%s aura %d mult must not be negative", owner, i))
+		}
+	}
+	return errs
+}
+
+// map keys in sorted order so validation errors are reported deterministically
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
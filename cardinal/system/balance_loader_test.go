@@ -0,0 +1,154 @@
+package system
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+const testBalancePath = "../data/balance.json"
+
+// the shipped balance file, decoded but not loaded into the registries
+func testBalance(t *testing.T) *balanceFile {
+	t.Helper()
+	raw, err := os.ReadFile(testBalancePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var balance balanceFile
+	if err := json.Unmarshal(raw, &balance); err != nil {
+		t.Fatal(err)
+	}
+	return &balance
+}
+
+func TestLoadBalance(t *testing.T) {
+	if err := LoadBalance(testBalancePath); err != nil {
+		t.Fatalf("LoadBalance() = %v", err)
+	}
+	if BalanceVersion == "" || len(BalanceChecksum) != 64 {
+		t.Errorf("version %q checksum %q, want a version and a sha256 hex checksum", BalanceVersion, BalanceChecksum)
+	}
+	if UnitRegistry["Mage"].Name != "Mage" {
+		t.Errorf("unit name %q, want the registry key", UnitRegistry["Mage"].Name)
+	}
+}
+
+func TestValidateBalance(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(balance *balanceFile)
+		wantErr string //substring of the error, empty for a valid balance
+	}{
+		{"shipped balance", func(balance *balanceFile) {}, ""},
+		{"missing version", func(balance *balanceFile) { balance.Version = "" }, "version is missing"},
+		{"no units", func(balance *balanceFile) { balance.Units = nil }, "no units defined"},
+		{"unknown class", func(balance *balanceFile) {
+			unit := balance.Units["Mage"]
+			unit.Class = "siege"
+			balance.Units["Mage"] = unit
+		}, `unit Mage has unknown class "siege"`},
+		{"damage frame after attack rate", func(balance *balanceFile) {
+			unit := balance.Units["Mage"]
+			unit.DamageFrame = unit.AttackRate + 1
+			balance.Units["Mage"] = unit
+		}, "unit Mage damageframe"},
+		{"attack radius past aggro radius", func(balance *balanceFile) {
+			unit := balance.Units["Mage"]
+			unit.AttackRadius = unit.AggroRadius + 1
+			balance.Units["Mage"] = unit
+		}, "unit Mage AttackRadius"},
+		{"current sp past max", func(balance *balanceFile) {
+			unit := balance.Units["Mage"]
+			unit.CurrentSP = unit.MaxSP + 1
+			balance.Units["Mage"] = unit
+		}, "unit Mage sp must satisfy"},
+		{"unit damage type", func(balance *balanceFile) {
+			unit := balance.Units["Mage"]
+			unit.DamageType = "poison"
+			balance.Units["Mage"] = unit
+		}, `unit Mage has unknown damagetype "poison"`},
+		{"unit aura stat", func(balance *balanceFile) {
+			unit := balance.Units["Mage"]
+			unit.Auras = []Aura{{Radius: 100, StatModifier: StatModifier{Stat: "Armor", Mult: 2}}}
+			balance.Units["Mage"] = unit
+		}, `unit Mage aura 0 has unknown stat "Armor"`},
+		{"missing sp", func(balance *balanceFile) { delete(balance.SpecialPowers, "Mage") }, "unit Mage has no specialPowers entry"},
+		{"sp frames out of order", func(balance *balanceFile) {
+			sp := balance.SpecialPowers["Mage"]
+			sp.DamageFrame = sp.DamageEndFrame + 1
+			balance.SpecialPowers["Mage"] = sp
+		}, "sp Mage frames must satisfy"},
+		{"sp without unit", func(balance *balanceFile) { balance.SpecialPowers["Ghost"] = balance.SpecialPowers["Mage"] }, "specialPowers entry Ghost has no matching unit"},
+		{"missing structure", func(balance *balanceFile) { delete(balance.Structures, "Tower") }, "structure Tower is missing"},
+		{"structure class", func(balance *balanceFile) {
+			structure := balance.Structures["Base"]
+			structure.Class = "melee"
+			balance.Structures["Base"] = structure
+		}, `structure Base must have class "structure"`},
+		{"structure aura radius", func(balance *balanceFile) {
+			structure := balance.Structures["Tower"]
+			structure.Auras = []Aura{{Radius: 0, StatModifier: StatModifier{Stat: "Damage", Mult: .5}}}
+			balance.Structures["Tower"] = structure
+		}, "structure Tower aura 0 radius must be positive"},
+		{"missing projectile", func(balance *balanceFile) { delete(balance.Projectiles, "ArcherLady") }, "projectile for ArcherLady is missing"},
+		{"projectile speed", func(balance *balanceFile) {
+			projectile := balance.Projectiles["Mage"]
+			projectile.Speed = 0
+			balance.Projectiles["Mage"] = projectile
+		}, "projectile for Mage speed must be positive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balance := testBalance(t)
+			tt.edit(balance)
+			err := validateBalance(balance)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateBalance() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("validateBalance() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDamageTypes(t *testing.T) {
+	tests := []struct {
+		name        string
+		damageType  string
+		resistances map[string]float32
+		wantErrs    []string //substrings of each error in order
+	}{
+		{"valid", DamageMagic, map[string]float32{DamagePhysical: 50, DamageFire: 0}, nil},
+		{"no resistances", DamageTrue, nil, nil},
+		{"unknown damage type", "poison", nil, []string{`unit Mage has unknown damagetype "poison"`}},
+		{"empty damage type", "", nil, []string{`unit Mage has unknown damagetype ""`}},
+		{"unknown resistance", DamagePhysical, map[string]float32{"poison": 10}, []string{`unit Mage has a resistance to unknown damage type "poison"`}},
+		{"true damage cannot be resisted", DamagePhysical, map[string]float32{DamageTrue: 10}, []string{`unit Mage has a resistance to unknown damage type "true"`}},
+		{"negative resistance", DamagePhysical, map[string]float32{DamageFire: -10}, []string{"unit Mage resistance to fire must not be negative"}},
+		{"every problem in key order", "poison", map[string]float32{"water": -1, DamageMagic: -5}, []string{
+			`unknown damagetype "poison"`,
+			"resistance to magic must not be negative",
+			`resistance to unknown damage type "water"`,
+			"resistance to water must not be negative",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateDamageTypes("unit Mage", tt.damageType, tt.resistances)
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("validateDamageTypes() = %v, want %d errors", errs, len(tt.wantErrs))
+			}
+			for i, want := range tt.wantErrs {
+				if !strings.Contains(errs[i].Error(), want) {
+					t.Errorf("error %d = %v, want it to contain %q", i, errs[i], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package system
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+)
+
+// nickname prefix of bot players, followed by the difficulty. persona tags can't contain ':' so no player can claim it
+const BotNicknamePrefix = "bot:"
+
+// how a bot difficulty plays
+type BotDifficulty struct {
+	ReactionTicks  int     //match ticks between two decisions
+	ReactionJitter int     //up to this many extra ticks are added to each wait
+	GoldReserve    float32 //gold held back for defending, only spent while an enemy unit is close to the base
+	SavesForCard   bool    //keeps the card it picked until it can pay for it, otherwise picks again each decision
+	PicksBest      bool    //plays the most expensive card it can pay for, otherwise a random card from hand
+	Defends        bool    //places units in the path of the enemy unit closest to its base, otherwise in front of its base
+}
+
+var BotDifficulties = map[string]BotDifficulty{
+	"easy":   {ReactionTicks: 50, ReactionJitter: 30},
+	"normal": {ReactionTicks: 25, ReactionJitter: 15, SavesForCard: true, Defends: true},
+	"hard":   {ReactionTicks: 10, ReactionJitter: 5, GoldReserve: 4, SavesForCard: true, PicksBest: true, Defends: true},
+}
+
+// enemy units closer than this to the bots base are a threat it answers
+var BotDefendRadius float32 = 3000
+
+// how far in front of its base the bot places units when nothing threatens it
+var BotPushOffset float32 = 400
+
+// rings around a placement point the bot tries when the point itself is taken or off the map
+var botPlacementRings = []float32{0, 150, 300, 450}
+
+// true if the nickname belongs to a bot player
+func isBot(nickname string) bool {
+	return strings.HasPrefix(nickname, BotNicknamePrefix)
+}
+
+// creates the controller that plays team for a bot, seeded from the finished match seed
+func spawnBot(world cardinal.WorldContext, gameState types.EntityID, matchID, team, difficulty string) error {
+	seed, err := cardinal.GetComponent[comp.MatchSeed](world, gameState)
+	if err != nil {
+		return fmt.Errorf("error getting match seed (bot.go/spawnBot): %w", err)
+	}
+	_, err = cardinal.Create(world,
+		comp.MatchId{MatchId: matchID},
+		comp.BotController{Team: team, Difficulty: difficulty, RngState: seed.Seed})
+	if err != nil {
+		return fmt.Errorf("error creating bot (bot.go/spawnBot): %w", err)
+	}
+	return nil
+}
+
+// lets every bot whose reaction time is up pick a card and play it like a player would
+func BotSystem(world cardinal.WorldContext) error {
+	return cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.BotController]())).
+		Each(world, func(id types.EntityID) bool {
+			matchID, bot, err := GetComponents2[comp.MatchId, comp.BotController](world, id)
+			if err != nil {
+				fmt.Printf("error getting bot components (bot.go): %v \n", err)
+				return false
+			}
+			if err := botTurn(world, matchID.MatchId, bot); err != nil {
+				fmt.Printf("error playing bot turn in %s (bot.go): %v \n", matchID.MatchId, err)
+			}
+			if err := cardinal.SetComponent(world, id, bot); err != nil {
+				fmt.Printf("error setting bot controller (bot.go): %v \n", err)
+				return false
+			}
+			return true
+		})
+}
+
+// one decision of a bot: pick a card from hand, check it can pay for it and place it
+func botTurn(world cardinal.WorldContext, matchID string, bot *comp.BotController) error {
+	gameState, err := getGameStateGSS(world, &comp.MatchId{MatchId: matchID})
+	if err != nil {
+		return err
+	}
+	timer, mapName, err := GetComponents2[comp.MatchTimer, comp.MapName](world, gameState)
+	if err != nil {
+		return err
+	}
+	if timer.Phase == "Ended" || timer.ElapsedTicks < bot.NextActionTick {
+		return nil
+	}
+
+	difficulty := BotDifficulties[bot.Difficulty]
+	rng := &seededRand{state: bot.RngState}
+	defer func() { bot.RngState = rng.state }()
+	bot.NextActionTick = timer.ElapsedTicks + botWait(difficulty, rng)
+
+	hand, gold, err := botHand(world, gameState, bot.Team)
+	if err != nil {
+		return err
+	}
+
+	var threat *comp.Position
+	if difficulty.Defends {
+		if threat, err = closestThreat(world, matchID, mapName.MapName, bot.Team); err != nil {
+			return err
+		}
+	}
+	budget := botBudget(difficulty, gold, threat != nil)
+
+	card := pickBotCard(difficulty, bot, hand, budget, rng)
+	if card == "" {
+		return nil
+	}
+	if float32(UnitRegistry[card].Cost) > budget {
+		if difficulty.SavesForCard {
+			bot.PlannedCard = card
+		}
+		return nil
+	}
+
+	x, y, ok := placeBotUnit(world, gameState, mapName.MapName, bot.Team, card, threat, rng)
+	if !ok {
+		return nil //no free spot, try again next decision
+	}
+	bot.PlannedCard = ""
+	return spawnUnitUS(world, gameState, mapName.MapName, bot.Team, &msg.CreateUnitMsg{
+		MatchID:   matchID,
+		UnitType:  card,
+		PositionX: x,
+		PositionY: y,
+	}, true)
+}
+
+// ticks until the bots next decision
+func botWait(difficulty BotDifficulty, rng *seededRand) int {
+	return difficulty.ReactionTicks + int(rng.next()%uint64(difficulty.ReactionJitter+1))
+}
+
+// gold the bot may spend, the reserve is only touched while its base is threatened
+func botBudget(difficulty BotDifficulty, gold float32, threatened bool) float32 {
+	if threatened {
+		return gold
+	}
+	return gold - difficulty.GoldReserve
+}
+
+// hand and gold of the player the bot controls
+func botHand(world cardinal.WorldContext, gameState types.EntityID, team string) ([]string, float32, error) {
+	p1, p2, err := getPlayerComponentsGSS(world, gameState)
+	if err != nil {
+		return nil, 0, err
+	}
+	if team == "Blue" {
+		return p1.Hand, p1.Gold, nil
+	}
+	return p2.Hand, p2.Gold, nil
+}
+
+// card the bot wants to play next, empty if it has nothing to play
+func pickBotCard(difficulty BotDifficulty, bot *comp.BotController, hand []string, budget float32, rng *seededRand) string {
+	if len(hand) == 0 {
+		return ""
+	}
+	if difficulty.SavesForCard && bot.PlannedCard != "" {
+		for _, card := range hand {
+			if card == bot.PlannedCard {
+				return card
+			}
+		}
+	}
+	if difficulty.PicksBest {
+		best := ""
+		for _, card := range hand {
+			cost := UnitRegistry[card].Cost
+			if float32(cost) <= budget && (best == "" || cost > UnitRegistry[best].Cost) {
+				best = card
+			}
+		}
+		if best != "" {
+			return best
+		}
+	}
+	return hand[rng.next()%uint64(len(hand))]
+}
+
+// position of the enemy unit closest to the bots base inside BotDefendRadius, nil if there is none
+func closestThreat(world cardinal.WorldContext, matchID, mapName, team string) (*comp.Position, error) {
+	baseX, baseY := botBase(mapName, team)
+	var threat *comp.Position
+	closest := BotDefendRadius
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == matchID
+	})
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.UnitTag]())).
+		Where(matchFilter).
+		Each(world, func(id types.EntityID) bool {
+			unitTeam, pos, err := GetComponents2[comp.Team, comp.Position](world, id)
+			if err != nil {
+				fmt.Printf("error getting unit components (bot.go/closestThreat): %v \n", err)
+				return false
+			}
+			if unitTeam.Team == team {
+				return true
+			}
+			distance := distanceBetweenTwoPoints(baseX, baseY, pos.PositionVectorX, pos.PositionVectorY)
+			if distance < closest {
+				closest, threat = distance, pos
+			}
+			return true
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error searching enemy units (bot.go/closestThreat): %w", err)
+	}
+	return threat, nil
+}
+
+// free spot for the card: in the path of the threat between it and the base, otherwise in front of the base.
+// rings around the point are tried in a random order so placements vary
+func placeBotUnit(world cardinal.WorldContext, gameState types.EntityID, mapName, team, card string, threat *comp.Position, rng *seededRand) (float32, float32, bool) {
+	unitType := UnitRegistry[card]
+	hash, err := cardinal.GetComponent[comp.SpatialHash](world, gameState)
+	if err != nil {
+		fmt.Printf("error getting spatial hash (bot.go/placeBotUnit): %v \n", err)
+		return 0, 0, false
+	}
+
+	baseX, baseY := botBase(mapName, team)
+	enemyX, enemyY := botBase(mapName, enemyTeam(team))
+	targetX, targetY := enemyX, enemyY
+	offset := BotPushOffset
+	if threat != nil {
+		targetX, targetY = threat.PositionVectorX, threat.PositionVectorY
+		offset = distanceBetweenTwoPoints(baseX, baseY, targetX, targetY) / 2
+	}
+	distance := distanceBetweenTwoPoints(baseX, baseY, targetX, targetY)
+	if distance == 0 {
+		return 0, 0, false
+	}
+	anchorX := baseX + (targetX-baseX)/distance*offset
+	anchorY := baseY + (targetY-baseY)/distance*offset
+
+	start := rng.next() % 8
+	for _, ring := range botPlacementRings {
+		for i := uint64(0); i < 8; i++ {
+			angle := float64((start+i)%8) * math.Pi / 4
+			x := anchorX + ring*float32(math.Cos(angle))
+			y := anchorY + ring*float32(math.Sin(angle))
+			if moveDirectionExsist(x, y, mapName, unitType.Class) && !CheckCollisionSpatialHash(hash, x, y, unitType.Radius, unitType.Class, true) {
+				return x, y, true
+			}
+			if ring == 0 {
+				break
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// base position of a team on a map
+func botBase(mapName, team string) (float32, float32) {
+	base := MapDataRegistry[mapName].Bases[0]
+	if team == "Red" {
+		base = MapDataRegistry[mapName].Bases[1]
+	}
+	return float32(base[0]), float32(base[1])
+}
+
+func enemyTeam(team string) string {
+	if team == "Blue" {
+		return "Red"
+	}
+	return "Blue"
+}
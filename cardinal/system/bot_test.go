@@ -0,0 +1,104 @@
+package system
+
+import (
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestPickBotCard(t *testing.T) {
+	if err := LoadBalance(testBalancePath); err != nil {
+		t.Fatalf("LoadBalance() error = %v", err)
+	}
+	hand := []string{"FireSpirit", "Mage", "LavaGolem", "LeafBird"}
+	const seed = 42
+	//card a random pick with the test seed lands on
+	random := hand[(&seededRand{state: seed}).next()%uint64(len(hand))]
+
+	tests := []struct {
+		name       string
+		difficulty string
+		planned    string
+		hand       []string
+		budget     float32
+		want       string
+	}{
+		{"easy picks at random", "easy", "", hand, 10, random},
+		{"easy forgets what it planned", "easy", "LavaGolem", hand, 10, random},
+		{"normal keeps saving for its card", "normal", "LavaGolem", hand, 1, "LavaGolem"},
+		{"normal picks again once its card left the hand", "normal", "Vampire", hand, 10, random},
+		{"normal picks at random without a plan", "normal", "", hand, 10, random},
+		{"hard plays the most expensive card it can pay for", "hard", "", hand, 3, "Mage"},
+		{"hard plays the first of equally priced cards", "hard", "", hand, 2, "FireSpirit"},
+		{"hard plays its best card with enough gold", "hard", "", hand, 10, "LavaGolem"},
+		{"hard keeps saving for its card", "hard", "LavaGolem", hand, 3, "LavaGolem"},
+		{"hard picks at random when it can pay for nothing", "hard", "", hand, 1, random},
+		{"empty hand", "hard", "", nil, 10, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot := &comp.BotController{Difficulty: tt.difficulty, PlannedCard: tt.planned}
+			got := pickBotCard(BotDifficulties[tt.difficulty], bot, tt.hand, tt.budget, &seededRand{state: seed})
+			if got != tt.want {
+				t.Errorf("pickBotCard() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBotBudget(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty string
+		gold       float32
+		threatened bool
+		want       float32
+	}{
+		{"easy spends everything", "easy", 6, false, 6},
+		{"hard holds its reserve back", "hard", 6, false, 2},
+		{"hard spends its reserve to defend", "hard", 6, true, 6},
+		{"hard below its reserve", "hard", 3, false, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := botBudget(BotDifficulties[tt.difficulty], tt.gold, tt.threatened); got != tt.want {
+				t.Errorf("botBudget(%s, %v, %v) = %v, want %v", tt.difficulty, tt.gold, tt.threatened, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBotWait(t *testing.T) {
+	for name, difficulty := range BotDifficulties {
+		t.Run(name, func(t *testing.T) {
+			rng := &seededRand{state: 7}
+			for i := 0; i < 200; i++ {
+				wait := botWait(difficulty, rng)
+				if wait < difficulty.ReactionTicks || wait > difficulty.ReactionTicks+difficulty.ReactionJitter {
+					t.Fatalf("botWait() = %d, want %d to %d", wait, difficulty.ReactionTicks, difficulty.ReactionTicks+difficulty.ReactionJitter)
+				}
+			}
+		})
+	}
+	//harder bots decide faster even on their slowest wait
+	if BotDifficulties["hard"].ReactionTicks+BotDifficulties["hard"].ReactionJitter >= BotDifficulties["normal"].ReactionTicks ||
+		BotDifficulties["normal"].ReactionTicks+BotDifficulties["normal"].ReactionJitter >= BotDifficulties["easy"].ReactionTicks {
+		t.Errorf("bot reaction times overlap between difficulties: %+v", BotDifficulties)
+	}
+}
+
+func TestIsBot(t *testing.T) {
+	tests := []struct {
+		nickname string
+		want     bool
+	}{
+		{BotNicknamePrefix + "easy", true},
+		{"alice", false},
+		{"robot:hard", false},
+	}
+	for _, tt := range tests {
+		if got := isBot(tt.nickname); got != tt.want {
+			t.Errorf("isBot(%q) = %v, want %v", tt.nickname, got, tt.want)
+		}
+	}
+}
@@ -37,12 +37,14 @@ func NewArcherLadySpawnSP() *archerLadySpawnSP {
 // update struct
 type archerLadyUpdateSP struct {
 	BaseDmgReductionFactor int
+	DamageType             string
 }
 
 // update vars
 func NewArcherLadyUpdateSP() *archerLadyUpdateSP {
 	return &archerLadyUpdateSP{
 		BaseDmgReductionFactor: 3,
+		DamageType:             DamagePhysical,
 	}
 }
 
@@ -51,7 +53,7 @@ func archerLadySpawn(world cardinal.WorldContext, id types.EntityID) error {
 	archerLady := NewArcherLadySpawnSP()
 
 	//get needed components
-	uPos, matchID, mapName, team, err := GetComponents4[comp.Position, comp.MatchId, comp.MapName, comp.Team](world, id)
+	uPos, matchID, mapName, team, unitName, err := GetComponents5[comp.Position, comp.MatchId, comp.MapName, comp.Team, comp.UnitName](world, id)
 	if err != nil {
 		return fmt.Errorf("get components (class archerladySpawn): %v", err)
 	}
@@ -85,6 +87,7 @@ func archerLadySpawn(world cardinal.WorldContext, id types.EntityID) error {
 			comp.Team{Team: team.Team},
 			comp.UnitRadius{UnitRadius: archerLady.RadiusArrows},
 			comp.SpEntity{SpName: archerLady.Name},
+			comp.Owner{UnitName: unitName.UnitName, Team: team.Team},
 			comp.Class{Class: "sp"},
 		)
 	}
@@ -162,31 +165,25 @@ func archerLadyUpdate(world cardinal.WorldContext, id types.EntityID) error {
 				destroyed.Destroyed = true
 				return destroyed
 			})
-			//reduce enemy current health
-			cardinal.UpdateComponent(world, closestUnit, func(health *comp.Health) *comp.Health {
-				if health == nil {
-					fmt.Printf("error retrieving collision health component ((class archerladyUpdate): \n")
-					return nil
-				}
-				///get target name
-				targetName, err := cardinal.GetComponent[comp.UnitName](world, closestUnit)
-				if err != nil {
-					fmt.Printf("error retrieving target unit name component (class archerladyUpdate): \n")
-					return nil
-				}
+			///get target name
+			targetName, err := cardinal.GetComponent[comp.UnitName](world, closestUnit)
+			if err != nil {
+				fmt.Printf("error retrieving target unit name component (class archerladyUpdate): \n")
+				return nil
+			}
 
-				if targetName.UnitName == "Base" || targetName.UnitName == "Tower" { // reduce damage to structures
-					archerLady := NewArcherLadyUpdateSP() // get reduction var
-					health.CurrentHP -= float32(dmg.Damage / archerLady.BaseDmgReductionFactor)
-				} else {
-					health.CurrentHP -= float32(dmg.Damage) // damage to non towers
-				}
+			// full damage to non towers
+			archerLady := NewArcherLadyUpdateSP() // get reduction and damage type vars
+			damage := float32(dmg.Damage)
+			if targetName.UnitName == "Base" || targetName.UnitName == "Tower" { // reduce damage to structures
+				damage = float32(dmg.Damage / archerLady.BaseDmgReductionFactor)
+			}
 
-				if health.CurrentHP < 0 { //dont get health get into neg
-					health.CurrentHP = 0
-				}
-				return health
-			})
+			//reduce enemy current health
+			if _, err := resolveDamage(world, id, closestUnit, damage, archerLady.DamageType); err != nil {
+				fmt.Printf("(class archerladyUpdate): %v \n", err)
+				return nil
+			}
 		}
 
 		//updated position and distance travelled
@@ -218,7 +215,7 @@ func archerLadyUpdate(world cardinal.WorldContext, id types.EntityID) error {
 // spawns projectile for archer basic attack
 func archerLadyAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.Attack) error {
 	//get units component
-	unitPosition, matchID, mapName, unitName, err := GetComponents4[comp.Position, comp.MatchId, comp.MapName, comp.UnitName](world, id)
+	unitPosition, matchID, mapName, unitName, team, err := GetComponents5[comp.Position, comp.MatchId, comp.MapName, comp.UnitName, comp.Team](world, id)
 	if err != nil {
 		return fmt.Errorf("unit components (class archerladyAttack.go): %v ", err)
 	}
@@ -236,10 +233,10 @@ func archerLadyAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.
 	}
 
 	//off set units arrow spawn location to match model on client
-	newX, newY := RelativeOffsetXY(unitPosition.PositionVectorX, unitPosition.PositionVectorY, unitPosition.RotationVectorX, unitPosition.RotationVectorY, ProjectileRegistry[unitName.UnitName].offSetX, ProjectileRegistry[unitName.UnitName].offSetY)
+	newX, newY := RelativeOffsetXY(unitPosition.PositionVectorX, unitPosition.PositionVectorY, unitPosition.RotationVectorX, unitPosition.RotationVectorY, ProjectileRegistry[unitName.UnitName].OffSetX, ProjectileRegistry[unitName.UnitName].OffSetY)
 
 	//carrot rotation towards enemy
-	rotX, rotY, rotZ := directionVectorBetweenTwoPoints3D(newX, newY, unitPosition.PositionVectorZ+ProjectileRegistry[unitName.UnitName].offSetZ, ePosition.PositionVectorX, ePosition.PositionVectorY, ePosition.PositionVectorZ+eCenOffset.CenterOffset)
+	rotX, rotY, rotZ := directionVectorBetweenTwoPoints3D(newX, newY, unitPosition.PositionVectorZ+ProjectileRegistry[unitName.UnitName].OffSetZ, ePosition.PositionVectorX, ePosition.PositionVectorY, ePosition.PositionVectorZ+eCenOffset.CenterOffset)
 
 	fmt.Printf("spawn: %f, %f, %f", rotX, rotY, rotZ)
 	//create projectile entity
@@ -251,15 +248,16 @@ func archerLadyAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.
 		comp.Position{
 			PositionVectorX: newX,
 			PositionVectorY: newY,
-			PositionVectorZ: unitPosition.PositionVectorZ + ProjectileRegistry[unitName.UnitName].offSetZ,
+			PositionVectorZ: unitPosition.PositionVectorZ + ProjectileRegistry[unitName.UnitName].OffSetZ,
 			RotationVectorX: rotX,
 			RotationVectorY: rotY,
 			RotationVectorZ: rotZ,
 		},
 		comp.MapName{MapName: mapName.MapName},
 		comp.Class{Class: "projectile"},
-		comp.Attack{Target: atk.Target, Damage: UnitRegistry[unitName.UnitName].Damage},
+		comp.Attack{Target: atk.Target, Damage: atk.Damage, DamageType: atk.DamageType},
 		comp.Destroyed{Destroyed: false},
+		comp.Owner{UnitName: unitName.UnitName, Team: team.Team},
 		comp.ProjectileTag{},
 	)
 
@@ -10,17 +10,19 @@ import (
 
 // fireSpiritSpawnSP struct contains configuration for an fire spirit in terms of their shooting properties.
 type fireSpiritSpawnSP struct {
-	Hieght    float32 //triangle hieght
-	BaseWidth float32 //triangle base width
-	Damage    float32 //damage per frame
+	Hieght     float32 //triangle hieght
+	BaseWidth  float32 //triangle base width
+	Damage     float32 //damage per frame
+	DamageType string
 }
 
 // NewFireSpiritSpawnSP creates a new instance of NewFireSpiritSP with default settings.
 func NewFireSpiritSpawnSP() *fireSpiritSpawnSP {
 	return &fireSpiritSpawnSP{
-		Hieght:    570,
-		BaseWidth: 385,
-		Damage:    3.5,
+		Hieght:     570,
+		BaseWidth:  385,
+		Damage:     3.5,
+		DamageType: DamageFire,
 	}
 }
 
@@ -82,13 +84,18 @@ func fireSpiritSpawn(world cardinal.WorldContext, id types.EntityID) error {
 
 		if team.Team != targetTeam.Team { //dont attack friendlies soilder!!
 
-			err = applyDamage(world, collID, fireSprit.Damage)
+			_, err = resolveDamage(world, id, collID, fireSprit.Damage, fireSprit.DamageType)
 
 			if err != nil {
 				fmt.Printf("error apply dmg (class fireSpirit.go): %v \n", err)
 				continue
 			}
 
+			//set them on fire, they keep burning after leaving the flames
+			if err := applyStatusEffect(world, id, collID, "Burn"); err != nil {
+				fmt.Printf("error applying burn (class fireSpirit.go): %v \n", err)
+			}
+
 		}
 	}
 
@@ -98,13 +105,7 @@ func fireSpiritSpawn(world cardinal.WorldContext, id types.EntityID) error {
 // overwrite phase_attack.go logic to support canneling
 func FireSpiritAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.Attack) error {
 
-	//get Unit CC component
-	cc, err := cardinal.GetComponent[comp.CC](world, id)
-	if err != nil {
-		fmt.Printf("error getting unit cc component (Fire Spirit Attack): %v", err)
-	}
-
-	if cc.Stun > 0 { //if unit stunned cannot attack
+	if stunned(world, id) { //if unit stunned cannot attack
 		return nil
 	}
 
@@ -119,6 +120,10 @@ func FireSpiritAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.
 
 		unitSp.Charged = true
 
+		if err := emitEntityEvent(world, id, "spTriggered"); err != nil {
+			return fmt.Errorf("(Fire Spirit Attack): %v", err)
+		}
+
 	} else if atk.Frame == 0 && unitSp.CurrentSp < unitSp.MaxSp { // in regular attack
 		unitSp.Charged = false
 	}
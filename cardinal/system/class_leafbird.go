@@ -10,19 +10,21 @@ import (
 
 // leafBirdSP struct contains configuration for an leafBirdSP in terms of their shooting properties.
 type leafBirdSP struct {
-	Hieght    float32 //triangle hieght
-	BaseWidth float32 //triangle base width
-	Push      float32
-	Damage    float32
+	Hieght     float32 //triangle hieght
+	BaseWidth  float32 //triangle base width
+	Push       float32
+	Damage     float32
+	DamageType string
 }
 
 // NewleafBirdSPSP creates a new instance of leafBirdSPSP with default settings.
 func NewLeafBirdSP() *leafBirdSP {
 	return &leafBirdSP{
-		Hieght:    930,
-		BaseWidth: 125,
-		Push:      50,
-		Damage:    1.4,
+		Hieght:     930,
+		BaseWidth:  125,
+		Push:       50,
+		Damage:     1.4,
+		DamageType: DamagePhysical,
 	}
 }
 
@@ -108,8 +110,11 @@ func leafBirdSp(world cardinal.WorldContext, id types.EntityID) error {
 						return fmt.Errorf("(applyKnockBack): %s ", err)
 					}
 				}
-				//apply damage
-				if err = applyDamage(world, collID, leafBird.Damage); err != nil {
+				//apply damage and slow
+				if _, err = resolveDamage(world, id, collID, leafBird.Damage, leafBird.DamageType); err != nil {
+					return fmt.Errorf("(leafBirdSp) - %v", err)
+				}
+				if err := applyStatusEffect(world, id, collID, "Slow"); err != nil {
 					return fmt.Errorf("(leafBirdSp) - %v", err)
 				}
 			}
@@ -126,13 +131,7 @@ func leafBirdSp(world cardinal.WorldContext, id types.EntityID) error {
 // overwrite phase_attack.go logic to support canneling
 func leafBirdAttackSystem(world cardinal.WorldContext, id types.EntityID, atk *comp.Attack) error {
 
-	//get Unit CC component
-	cc, err := cardinal.GetComponent[comp.CC](world, id)
-	if err != nil {
-		fmt.Printf("error getting unit cc component ( leafBirdAttackSystem): %v", err)
-	}
-
-	if cc.Stun > 0 { //if unit stunned cannot attack
+	if stunned(world, id) { //if unit stunned cannot attack
 		return nil
 	}
 
@@ -145,6 +144,9 @@ func leafBirdAttackSystem(world cardinal.WorldContext, id types.EntityID, atk *c
 	//check if in a SP animation or a regular attack
 	if atk.Frame == 0 && unitSp.CurrentSp >= unitSp.MaxSp { //In special power
 		unitSp.Charged = true
+		if err := emitEntityEvent(world, id, "spTriggered"); err != nil {
+			return fmt.Errorf("(leafBirdAttackSystem): %v", err)
+		}
 	} else if atk.Frame == 0 && unitSp.CurrentSp < unitSp.MaxSp { // in regular attack
 		unitSp.Charged = false
 	}
@@ -152,7 +154,7 @@ func leafBirdAttackSystem(world cardinal.WorldContext, id types.EntityID, atk *c
 	//if unit is in its damage frame and not charged
 	if atk.Frame == atk.DamageFrame && !unitSp.Charged {
 		//peck em >:D
-		err = applyDamage(world, atk.Target, atk.Damage)
+		_, err = resolveDamage(world, id, atk.Target, atk.Damage, atk.DamageType)
 		if err != nil {
 			return fmt.Errorf("(leafBirdAttackSystem): %v", err)
 		}
@@ -8,19 +8,7 @@ import (
 	"pkg.world.dev/world-engine/cardinal/types"
 )
 
-// update struct
-type mageUpdateSP struct {
-	frameCount int
-}
-
-// update vars
-func NewMageUpdateSP() *mageUpdateSP {
-	return &mageUpdateSP{
-		frameCount: 25,
-	}
-}
-
-// Stuns mages target
+// strips the buffs of the mages target and stuns it
 func MageSpawnSP(world cardinal.WorldContext, id types.EntityID, unitSp *comp.Sp) error {
 	// get unit attack component
 	unitAtk, err := cardinal.GetComponent[comp.Attack](world, id)
@@ -35,120 +23,21 @@ func MageSpawnSP(world cardinal.WorldContext, id types.EntityID, unitSp *comp.Sp
 		tarId = unitAtk.Target
 	}
 
-	//Add stun effect to target effects list
-	err = cardinal.UpdateComponent(world, tarId, func(effect *comp.EffectsList) *comp.EffectsList {
-		if effect == nil {
-			fmt.Printf("error retrieving effect list (class mage.go) \n")
-			return nil
-		}
-
-		effect.EffectsList["MageStun"]++ //increase mage stun count on target
-
-		return effect
-	})
-	if err != nil {
-		return fmt.Errorf("error on effect list (class mage.go): %v", err)
-	}
-
-	//get matchid component
-	matchID, err := cardinal.GetComponent[comp.MatchId](world, id)
-	if err != nil {
-		return fmt.Errorf("error getting matchID comp (class mage.go): %w", err)
+	//purge the target, then stun it
+	if err := dispelStatusEffects(world, tarId, true); err != nil {
+		return fmt.Errorf("(class mage.go): %v", err)
 	}
-	//get new uid
-	UID, err := getNextUID(world, matchID.MatchId)
-	if err != nil {
-		return fmt.Errorf("(class mage.go): %v - ", err)
-	}
-	//create stun entity attached to target
-	_, err = cardinal.Create(world,
-		comp.MatchId{MatchId: matchID.MatchId},
-		comp.UID{UID: UID},
-		comp.SpEntity{SpName: "MageSP"},
-		comp.IntTracker{Num: 0}, //tracks duration
-		comp.Target{Target: tarId},
-	)
-	if err != nil {
-		return fmt.Errorf("error creating stun entity (class mage.go): %v", err)
+	if err := applyStatusEffect(world, id, tarId, "MageStun"); err != nil {
+		return fmt.Errorf("(class mage.go): %v", err)
 	}
 
-	return err
-}
-
-// called every tick to update the active stuns to see if they have surpased duration limit
-func MageUpdate(world cardinal.WorldContext, id types.EntityID) error {
-	mage := NewMageUpdateSP() // get mage vars
-	// get target id
-	tarID, err := cardinal.GetComponent[comp.Target](world, id)
-	if err != nil {
-		return fmt.Errorf("error getting target comp (class mage.go): %w", err)
-	}
-
-	//get targets cc component
-	cc, err := cardinal.GetComponent[comp.CC](world, tarID.Target)
-	if err != nil {
-		return fmt.Errorf("error getting target cc component(class mage.go): %w", err)
-	}
-	//if target not stunned then stun it (should not happen but just to be careful)
-	if cc.Stun == 0 {
-		cc.Stun++
-	}
-
-	// get tracker holding number of frames stun has been active for (stun count)
-	stunCount, err := cardinal.GetComponent[comp.IntTracker](world, id)
-	if err != nil {
-		return fmt.Errorf("error retrieving int tracker component (class mage.go): %w", err)
-	}
-	stunCount.Num += 1                    // increase stun frame count
-	if stunCount.Num >= mage.frameCount { //if stun count is greater than mage max stun count
-
-		//remove stun of target
-		err := cardinal.UpdateComponent(world, tarID.Target, func(effect *comp.EffectsList) *comp.EffectsList {
-			if effect == nil {
-				fmt.Printf("error retrieving effect list (class mage.go) \n")
-				return nil
-			}
-
-			if list, ok := effect.EffectsList["MageStun"]; ok { // if key exists
-				if list <= 1 { // if 1 or less of this buff active remove
-					delete(effect.EffectsList, "MageStun")
-				} else { // if more then 1 active reduce by 1
-					effect.EffectsList["MageStun"] -= 1
-				}
-			}
-			return effect
-		})
-		if err != nil {
-			return err
-		}
-
-		// delete entity
-		if err := cardinal.Remove(world, id); err != nil {
-			return fmt.Errorf("error removing entity sp (class mage.go): %w", err)
-		}
-
-		cc.Stun-- //reduce stun count by 1
-		if cc.Stun < 0 {
-			cc.Stun = 0
-		}
-
-	} else { // else update stun count component
-		if err := cardinal.SetComponent(world, id, stunCount); err != nil {
-			return fmt.Errorf("error setting stun count (class mage.go): %w", err)
-		}
-	}
-	//set cc component
-	if err = cardinal.SetComponent(world, tarID.Target, cc); err != nil {
-		return fmt.Errorf("error setting stun component on target (class mage.go): %w", err)
-	}
-
-	return err
+	return nil
 }
 
 // spawns projectile for mage basic attack
 func mageAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.Attack) error {
 	//get units component
-	unitPosition, matchID, mapName, unitName, err := GetComponents4[comp.Position, comp.MatchId, comp.MapName, comp.UnitName](world, id)
+	unitPosition, matchID, mapName, unitName, team, err := GetComponents5[comp.Position, comp.MatchId, comp.MapName, comp.UnitName, comp.Team](world, id)
 	if err != nil {
 		return fmt.Errorf("unit components (class mageAttack.go): %v ", err)
 	}
@@ -158,7 +47,7 @@ func mageAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.Attack
 		return fmt.Errorf("(class mageAttack.go): %v ", err)
 	}
 	// set offset to units mesh in client
-	newX, newY := RelativeOffsetXY(unitPosition.PositionVectorX, unitPosition.PositionVectorY, unitPosition.RotationVectorX, unitPosition.RotationVectorY, ProjectileRegistry[unitName.UnitName].offSetX, ProjectileRegistry[unitName.UnitName].offSetY)
+	newX, newY := RelativeOffsetXY(unitPosition.PositionVectorX, unitPosition.PositionVectorY, unitPosition.RotationVectorX, unitPosition.RotationVectorY, ProjectileRegistry[unitName.UnitName].OffSetX, ProjectileRegistry[unitName.UnitName].OffSetY)
 	//create projectile entity
 	_, err = cardinal.Create(world,
 		comp.MatchId{MatchId: matchID.MatchId},
@@ -168,15 +57,16 @@ func mageAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.Attack
 		comp.Position{
 			PositionVectorX: newX,
 			PositionVectorY: newY,
-			PositionVectorZ: unitPosition.PositionVectorZ + ProjectileRegistry[unitName.UnitName].offSetZ,
+			PositionVectorZ: unitPosition.PositionVectorZ + ProjectileRegistry[unitName.UnitName].OffSetZ,
 			RotationVectorX: unitPosition.RotationVectorX,
 			RotationVectorY: unitPosition.RotationVectorY,
 			RotationVectorZ: unitPosition.RotationVectorZ,
 		},
 		comp.Class{Class: "projectile"},
 		comp.MapName{MapName: mapName.MapName},
-		comp.Attack{Target: atk.Target, Damage: UnitRegistry[unitName.UnitName].Damage},
+		comp.Attack{Target: atk.Target, Damage: atk.Damage, DamageType: atk.DamageType},
 		comp.Destroyed{Destroyed: false},
+		comp.Owner{UnitName: unitName.UnitName, Team: team.Team},
 		comp.ProjectileTag{},
 	)
 
@@ -11,9 +11,14 @@ import (
 
 // updates all SP's spawned
 func SpUpdater(world cardinal.WorldContext) error {
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
 
-	err := cardinal.NewSearch().Entity(
-		filter.Contains(filter.Component[comp.SpEntity]())).Each(world, func(id types.EntityID) bool {
+	err = cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.SpEntity]())).Where(activeFilter).Each(world, func(id types.EntityID) bool {
 		//get sp name
 		spEntity, err := cardinal.GetComponent[comp.SpEntity](world, id)
 		if err != nil {
@@ -24,10 +29,6 @@ func SpUpdater(world cardinal.WorldContext) error {
 		switch spEntity.SpName {
 		case "ArcherLadySP":
 			err = archerLadyUpdate(world, id)
-		case "MageSP":
-			err = MageUpdate(world, id)
-		case "VampireSP":
-			err = vampireUpdateSP(world, id)
 		}
 
 		if err != nil {
@@ -56,9 +57,14 @@ func spSpawner(world cardinal.WorldContext, id types.EntityID, name string, sp *
 		err = MageSpawnSP(world, id, sp)
 	case "Vampire":
 		err = vampireSpawnSP(world, id)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
 	}
 
-	return err
+	return emitEntityEvent(world, id, "spSpawned")
 }
 
 // triggers unit attack
@@ -71,13 +77,13 @@ func ClassAttack(world cardinal.WorldContext, id types.EntityID, name string, at
 	case "Base":
 		err = towerAttack(world, id, atk)
 	case "LavaGolem":
-		err = lavaGolemAttack(world, atk)
+		err = lavaGolemAttack(world, id, atk)
 	case "Mage":
 		err = mageAttack(world, id, atk)
 	case "Tower":
 		err = towerAttack(world, id, atk)
 	case "Vampire":
-		err = vampireAttack(world, atk)
+		err = vampireAttack(world, id, atk)
 	}
 
 	return err
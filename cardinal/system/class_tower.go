@@ -27,10 +27,15 @@ func TowerConverterSystem(world cardinal.WorldContext) error {
 	stateFilter := cardinal.ComponentFilter(func(m comp.State) bool {
 		return m.State == "Converting"
 	})
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
 	//for each tower still converting teams
-	err := cardinal.NewSearch().Entity(
+	err = cardinal.NewSearch().Entity(
 		filter.Contains(filter.Component[comp.StructureTag]())).
-		Where(stateFilter).Each(world, func(id types.EntityID) bool {
+		Where(cardinal.AndFilter(stateFilter, activeFilter)).Each(world, func(id types.EntityID) bool {
 
 		tower := NewTower()
 
@@ -59,6 +64,12 @@ func TowerConverterSystem(world cardinal.WorldContext) error {
 					return health
 				}
 
+				// tower can fight for its new team
+				if err := emitEntityEvent(world, id, "towerReady"); err != nil {
+					fmt.Printf("(tower conversion.go): %s", err)
+					return health
+				}
+
 			}
 			return health
 		})
@@ -76,7 +87,7 @@ func TowerConverterSystem(world cardinal.WorldContext) error {
 // spawns projectile for tower basic attack
 func towerAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.Attack) error {
 	//get units component
-	unitPosition, matchID, mapName, unitName, err := GetComponents4[comp.Position, comp.MatchId, comp.MapName, comp.UnitName](world, id) //reusing
+	unitPosition, matchID, mapName, unitName, team, err := GetComponents5[comp.Position, comp.MatchId, comp.MapName, comp.UnitName, comp.Team](world, id) //reusing
 	if err != nil {
 		return fmt.Errorf("tower components (class towerAttack.go): %v ", err)
 	}
@@ -95,13 +106,14 @@ func towerAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.Attac
 		comp.Position{
 			PositionVectorX: unitPosition.PositionVectorX,
 			PositionVectorY: unitPosition.PositionVectorY,
-			PositionVectorZ: unitPosition.PositionVectorZ + ProjectileRegistry[unitName.UnitName].offSetZ,
+			PositionVectorZ: unitPosition.PositionVectorZ + ProjectileRegistry[unitName.UnitName].OffSetZ,
 			RotationVectorX: unitPosition.RotationVectorX,
 			RotationVectorY: unitPosition.RotationVectorY,
 			RotationVectorZ: unitPosition.RotationVectorZ},
 		comp.MapName{MapName: mapName.MapName},
-		comp.Attack{Target: atk.Target, Damage: StructureDataRegistry[unitName.UnitName].Damage},
+		comp.Attack{Target: atk.Target, Damage: atk.Damage, DamageType: atk.DamageType},
 		comp.Destroyed{Destroyed: false},
+		comp.Owner{UnitName: unitName.UnitName, Team: team.Team},
 		comp.ProjectileTag{},
 	)
 
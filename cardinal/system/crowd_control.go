@@ -15,7 +15,7 @@ func applyKnockBack(world cardinal.WorldContext, id types.EntityID, hash *comp.S
 		tempX := pos.PositionVectorX + dir.RotationVectorX*push
 		tempY := pos.PositionVectorY + dir.RotationVectorY*push
 		//check that unit isnt walking through out of bounds towards a found unit
-		if !moveDirectionExsist(tempX, tempY, mapName.MapName) {
+		if !moveDirectionExsist(tempX, tempY, mapName.MapName, class.Class) {
 			//find closest occupiable lication from target location to current
 			tempX, tempY = pushFromPtBtoA(world, hash, id, pos.PositionVectorX, pos.PositionVectorY, tempX, tempY, rad.UnitRadius, mapName)
 
@@ -23,7 +23,7 @@ func applyKnockBack(world cardinal.WorldContext, id types.EntityID, hash *comp.S
 
 				position := &comp.Position{PositionVectorX: pos.PositionVectorX, PositionVectorY: pos.PositionVectorY}
 
-				findInboundsRotation(angle, rotation, position, dir.RotationVectorX, dir.RotationVectorY, push, mapName)
+				findInboundsRotation(angle, rotation, position, dir.RotationVectorX, dir.RotationVectorY, push, mapName, class.Class)
 
 				tempX = position.PositionVectorX
 				tempY = position.PositionVectorY
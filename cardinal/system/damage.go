@@ -0,0 +1,111 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// damage types attacks and special powers deal
+const (
+	DamagePhysical = "physical"
+	DamageMagic    = "magic"
+	DamageFire     = "fire"
+	DamageTrue     = "true" //ignores resistances
+)
+
+var damageTypes = map[string]bool{DamagePhysical: true, DamageMagic: true, DamageFire: true, DamageTrue: true}
+
+// deals damage of a type from attackerID to targetID. the target's resistance to the type mitigates it, then its damage
+// taken modifiers scale it, then its shields absorb what they can before the rest comes off hp. the amount that came off
+// hp is recorded in the match stats and returned, it and the absorbed amount are published for combat text
+func resolveDamage(world cardinal.WorldContext, attackerID, targetID types.EntityID, damage float32, damageType string) (float32, error) {
+	source, err := damageSource(world, attackerID)
+	if err != nil {
+		return 0, fmt.Errorf("(damage.go/resolveDamage): %w", err)
+	}
+	return resolveSourceDamage(world, source, targetID, damage, damageType)
+}
+
+// resolveDamage for damage credited to a unit type and team instead of an entity, the unit that applied a damage over
+// time effect can be gone by the time it ticks
+func resolveSourceDamage(world cardinal.WorldContext, source comp.Owner, targetID types.EntityID, damage float32, damageType string) (float32, error) {
+	if !damageTypes[damageType] {
+		return 0, fmt.Errorf("unknown damage type %q (damage.go/resolveSourceDamage)", damageType)
+	}
+	//entities spawned before defense existed take the damage as is
+	if defense, err := cardinal.GetComponent[comp.Defense](world, targetID); err == nil {
+		damage = mitigatedDamage(damage, damageType, defense)
+	}
+	if damage <= 0 {
+		return 0, nil
+	}
+
+	var dealt, absorbed float32
+	var killed bool
+	// reduce shields then health by the mitigated damage
+	err := cardinal.UpdateComponent(world, targetID, func(health *comp.Health) *comp.Health {
+		if health == nil {
+			fmt.Printf("error retrieving Health component (damage.go/resolveSourceDamage): \n")
+			return nil
+		}
+		dealt, absorbed, killed = takeDamage(health, damage)
+		return health
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error on attack (damage.go/resolveSourceDamage): %v", err)
+	}
+
+	if absorbed > 0 {
+		if err := emitDamageEvent(world, targetID, "absorbed", absorbed, damageType); err != nil {
+			return 0, fmt.Errorf("(damage.go/resolveSourceDamage): %v", err)
+		}
+	}
+	if dealt <= 0 {
+		return 0, nil
+	}
+	if err := recordDamage(world, source, targetID, dealt, killed); err != nil {
+		return dealt, fmt.Errorf("(damage.go/resolveSourceDamage): %v", err)
+	}
+	if err := emitDamageEvent(world, targetID, "damaged", dealt, damageType); err != nil {
+		return dealt, fmt.Errorf("(damage.go/resolveSourceDamage): %v", err)
+	}
+	return dealt, nil
+}
+
+// takes mitigated damage off the shields then hp. returns the damage hp took, the damage the shields absorbed and
+// whether it took hp to 0
+func takeDamage(health *comp.Health, damage float32) (dealt, absorbed float32, killed bool) {
+	throughShields := absorbDamage(health, damage)
+	absorbed = damage - throughShields
+	before := health.CurrentHP
+	health.CurrentHP -= throughShields
+	if health.CurrentHP < 0 {
+		health.CurrentHP = 0 //never have negative health
+	}
+	dealt = before - health.CurrentHP
+	killed = before > 0 && health.CurrentHP == 0
+	return dealt, absorbed, killed
+}
+
+// damage left after the target's resistance to the type and its damage taken multiplier
+func mitigatedDamage(damage float32, damageType string, defense *comp.Defense) float32 {
+	if damageType != DamageTrue {
+		damage *= 100 / (100 + defense.Resistances[damageType])
+	}
+	return damage * defense.DamageTaken
+}
+
+// tells clients how much damage of which type the target took or its shields absorbed so they can show it over the target
+func emitDamageEvent(world cardinal.WorldContext, targetID types.EntityID, eventType string, amount float32, damageType string) error {
+	matchID, event, err := entityEvent(world, targetID, eventType)
+	if err != nil {
+		return fmt.Errorf("(damage.go/emitDamageEvent): %w", err)
+	}
+	event.Name = damageType
+	event.Value = amount
+	return emitMatchEvent(world, matchID, event)
+}
@@ -0,0 +1,33 @@
+package system
+
+import (
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestMitigatedDamage(t *testing.T) {
+	tests := []struct {
+		name       string
+		damage     float32
+		damageType string
+		defense    comp.Defense
+		want       float32
+	}{
+		{"no resistances", 20, DamagePhysical, comp.Defense{DamageTaken: 1}, 20},
+		{"100 resistance halves", 20, DamagePhysical, comp.Defense{Resistances: map[string]float32{DamagePhysical: 100}, DamageTaken: 1}, 10},
+		{"300 resistance quarters", 20, DamageMagic, comp.Defense{Resistances: map[string]float32{DamageMagic: 300}, DamageTaken: 1}, 5},
+		{"other type resisted", 20, DamageFire, comp.Defense{Resistances: map[string]float32{DamagePhysical: 100}, DamageTaken: 1}, 20},
+		{"true damage ignores resistance", 20, DamageTrue, comp.Defense{Resistances: map[string]float32{DamageTrue: 100}, DamageTaken: 1}, 20},
+		{"damage taken scales", 20, DamagePhysical, comp.Defense{DamageTaken: .5}, 10},
+		{"damage taken after resistance", 20, DamagePhysical, comp.Defense{Resistances: map[string]float32{DamagePhysical: 100}, DamageTaken: 1.5}, 15},
+		{"damage taken scales true damage", 20, DamageTrue, comp.Defense{DamageTaken: 2}, 40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mitigatedDamage(tt.damage, tt.damageType, &tt.defense); got != tt.want {
+				t.Errorf("mitigatedDamage(%v, %q) = %v, want %v", tt.damage, tt.damageType, got, tt.want)
+			}
+		})
+	}
+}
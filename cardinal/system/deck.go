@@ -0,0 +1,48 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+)
+
+// number of cards a player brings into a match
+var DeckSize = 8
+
+// number of cards dealt into the opening hand, the rest make up the draw pile
+var HandSize = 3
+
+// how many copies of the same unit a deck may hold (the registry has fewer units than DeckSize)
+var MaxCardCopies = 2
+
+// checks a deck sent with create-match has the right size, only known units and respects the copy limit
+func validateDeck(deck []string) error {
+	var errs []error
+
+	if len(deck) != DeckSize {
+		errs = append(errs, fmt.Errorf("deck has %d cards, must have %d", len(deck), DeckSize))
+	}
+
+	copies := make(map[string]int, len(deck))
+	for _, card := range deck {
+		if _, ok := UnitRegistry[card]; !ok {
+			errs = append(errs, fmt.Errorf("unknown unit %q in deck", card))
+			continue
+		}
+		copies[card]++
+		if copies[card] == MaxCardCopies+1 {
+			errs = append(errs, fmt.Errorf("deck has more than %d copies of %s", MaxCardCopies, card))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid deck (deck.go): %w", err)
+	}
+	return nil
+}
+
+// deals the opening hand from the top of the deck, the remaining cards become the draw pile
+func dealHand(deck []string) ([]string, []string) {
+	hand := append([]string(nil), deck[:HandSize]...)
+	drawPile := append([]string(nil), deck[HandSize:]...)
+	return hand, drawPile
+}
@@ -0,0 +1,91 @@
+package system
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateDeck(t *testing.T) {
+	if err := LoadBalance(testBalancePath); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		deck    []string
+		wantErr string //substring of the error, empty for a valid deck
+	}{
+		{"valid", []string{"ArcherLady", "FireSpirit", "LavaGolem", "LeafBird", "Mage", "Vampire", "Mage", "Vampire"}, ""},
+		{"too few cards", []string{"ArcherLady", "FireSpirit", "LavaGolem"}, "deck has 3 cards, must have 8"},
+		{"too many cards", []string{"ArcherLady", "FireSpirit", "LavaGolem", "LeafBird", "Mage", "Vampire", "Mage", "Vampire", "LeafBird"}, "deck has 9 cards, must have 8"},
+		{"empty", nil, "deck has 0 cards, must have 8"},
+		{"unknown unit", []string{"ArcherLady", "FireSpirit", "LavaGolem", "LeafBird", "Mage", "Vampire", "Mage", "Dragon"}, `unknown unit "Dragon" in deck`},
+		{"structure is not a card", []string{"ArcherLady", "FireSpirit", "LavaGolem", "LeafBird", "Mage", "Vampire", "Mage", "Tower"}, `unknown unit "Tower" in deck`},
+		{"too many copies", []string{"Mage", "Mage", "Mage", "LeafBird", "ArcherLady", "Vampire", "FireSpirit", "Vampire"}, "more than 2 copies of Mage"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDeck(tt.deck)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateDeck() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("validateDeck() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDeckReportsEveryProblem(t *testing.T) {
+	if err := LoadBalance(testBalancePath); err != nil {
+		t.Fatal(err)
+	}
+	err := validateDeck([]string{"Mage", "Mage", "Mage", "Dragon"})
+	if err == nil {
+		t.Fatal("validateDeck() = nil, want an error")
+	}
+	for _, want := range []string{"deck has 4 cards", `unknown unit "Dragon"`, "more than 2 copies of Mage"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("validateDeck() = %v, missing %q", err, want)
+		}
+	}
+}
+
+func TestDealHand(t *testing.T) {
+	tests := []struct {
+		name     string
+		deck     []string
+		wantHand []string
+		wantPile []string
+	}{
+		{
+			name:     "full deck",
+			deck:     []string{"a", "b", "c", "d", "e", "f", "g", "h"},
+			wantHand: []string{"a", "b", "c"},
+			wantPile: []string{"d", "e", "f", "g", "h"},
+		},
+		{
+			name:     "just a hand",
+			deck:     []string{"a", "b", "c"},
+			wantHand: []string{"a", "b", "c"},
+			wantPile: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deck := append([]string(nil), tt.deck...)
+			hand, pile := dealHand(deck)
+			if !reflect.DeepEqual(hand, tt.wantHand) || !reflect.DeepEqual(pile, tt.wantPile) {
+				t.Fatalf("dealHand() = %v, %v, want %v, %v", hand, pile, tt.wantHand, tt.wantPile)
+			}
+			//hand and pile are copies, playing a card must not change the deck the player sent
+			hand[0] = "x"
+			if deck[0] != tt.deck[0] {
+				t.Errorf("dealHand() hand shares memory with the deck")
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package system
+
+import "errors"
+
+// reasons a message is rejected, systems wrap them with %w so callers can match them with errors.Is
+var (
+	ErrMatchNotFound  = errors.New("match not found")
+	ErrMatchEnded     = errors.New("match has ended")
+	ErrMatchFull      = errors.New("match already has two players")
+	ErrAlreadyInMatch = errors.New("persona is already a player in this match")
+	ErrNotInMatch     = errors.New("persona is not a player in this match")
+	ErrWrongTeam      = errors.New("persona does not play for this team")
+	ErrUnknownMap     = errors.New("unknown map")
+	ErrMapMismatch    = errors.New("map does not match the map the match is played on")
+	ErrNotAdmin       = errors.New("persona is not an admin")
+	ErrAlreadyQueued  = errors.New("persona is already in the matchmaking queue")
+	ErrNotQueued      = errors.New("persona is not in the matchmaking queue")
+	ErrReservedID     = errors.New("match id is reserved for matchmaking")
+	ErrMatchExists    = errors.New("match id is already in use")
+	ErrUnknownBot     = errors.New("unknown bot difficulty")
+	ErrPlayerSpectate = errors.New("players can't spectate their own match")
+	ErrSpectating     = errors.New("persona is already spectating this match")
+	ErrNotSpectating  = errors.New("persona is not spectating this match")
+	ErrBadKeyHash     = errors.New("view key hash must be a hex sha256")
+	ErrBadViewKey     = errors.New("view key does not belong to a player of this match")
+)
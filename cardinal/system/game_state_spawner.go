@@ -2,6 +2,8 @@ package system
 
 import (
 	"fmt"
+	"maps"
+	"strings"
 
 	"pkg.world.dev/world-engine/cardinal"
 	"pkg.world.dev/world-engine/cardinal/iterators"
@@ -14,7 +16,7 @@ import (
 
 // Spawns Game state for a new match.
 // called by create_match.go msg.
-func GameStateSpawnerSystem(world cardinal.WorldContext) error {
+func (r Rules) GameStateSpawnerSystem(world cardinal.WorldContext) error {
 	return cardinal.EachMessage(world,
 		func(create cardinal.TxData[msg.CreateMatchMsg]) (msg.CreateMatchResult, error) {
 			//create filter for matching ID's
@@ -22,8 +24,32 @@ func GameStateSpawnerSystem(world cardinal.WorldContext) error {
 				return m.MatchId == create.Msg.MatchID
 			})
 
-			// Search for existing matches.
-			existingMatchSearch := cardinal.NewSearch().Entity(filter.Contains(filter.Component[comp.MatchId]())).Where(matchFilter)
+			//check the players deck before joining them to a match
+			if err := validateDeck(create.Msg.Deck); err != nil {
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("(game_state_spawner.go): %w", err)
+			}
+
+			//matchmaking hands out its own ids, a replay re-creates its matches through create-match
+			if !r.replaying() && strings.HasPrefix(create.Msg.MatchID, QueueMatchIDPrefix) {
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("%w (game_state_spawner.go): %s", ErrReservedID, create.Msg.MatchID)
+			}
+
+			//leave the queue first, matchmaking would pair the persona into a second match
+			if err := checkNotQueued(world, create.Tx.PersonaTag); err != nil {
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("(game_state_spawner.go): %w", err)
+			}
+
+			if _, ok := MapDataRegistry[create.Msg.MapName]; !ok {
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("%w (game_state_spawner.go): %s", ErrUnknownMap, create.Msg.MapName)
+			}
+
+			// match ids are single use, a finished match keeps its result
+			if _, _, err := getMatchResult(world, create.Msg.MatchID); err == nil {
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("%w, match ids are single use (game_state_spawner.go): %s", ErrMatchEnded, create.Msg.MatchID)
+			}
+
+			// Search for existing matches waiting on player2.
+			existingMatchSearch := cardinal.NewSearch().Entity(filter.Contains(filter.Component[comp.MatchId](), filter.Component[comp.Player1]())).Where(matchFilter)
 			count, err := existingMatchSearch.Count(world)
 			if err != nil {
 				return msg.CreateMatchResult{Success: false}, fmt.Errorf("error during search (game_state_spawner.go): %w", err)
@@ -32,26 +58,9 @@ func GameStateSpawnerSystem(world cardinal.WorldContext) error {
 			// No match found.
 			if count == 0 {
 				//Create new gamestate
-				_, err := cardinal.Create(world,
-					comp.MatchId{MatchId: create.Msg.MatchID},
-					comp.UID{UID: 0},
-					comp.Player1{
-						Nickname:    create.Tx.PersonaTag,
-						Hand:        []string{"Vampire", "FireSpirit", "ArcherLady"},
-						Deck:        []string{"Vampire"},
-						RemovalList: make(map[int]bool),
-						Gold:        5,
-					},
-					comp.SpatialHash{Cells: make(map[string]comp.SpatialCell),
-						CellSize: SpatialGridCellSize,
-						StartX:   float32(MapDataRegistry[create.Msg.MapName].StartX),
-						StartY:   float32(MapDataRegistry[create.Msg.MapName].StartY)},
-				)
-
-				if err != nil {
-					return msg.CreateMatchResult{Success: false}, fmt.Errorf("error creating match (game_state_spawner.go): %v", err)
+				if _, err := createGameStateGSS(world, create.Msg.MatchID, create.Msg.MapName, create.Tx.PersonaTag, create.Msg.Deck, r.recordedTxHash(create.Hash)); err != nil {
+					return msg.CreateMatchResult{Success: false}, err
 				}
-
 				return msg.CreateMatchResult{Success: true}, nil // end logic for player1
 			}
 
@@ -62,53 +71,137 @@ func GameStateSpawnerSystem(world cardinal.WorldContext) error {
 				return msg.CreateMatchResult{Success: false}, fmt.Errorf("error getting game state for player 2 add comp (game_state_spawner.go): %w", err)
 			}
 
-			//add player2 component
-			err = cardinal.AddComponentTo[comp.Player2](world, matchFound)
-			if err != nil {
-				return msg.CreateMatchResult{Success: false}, fmt.Errorf("error adding Player2 component 1 (game_state_spawner.go): %w", err)
+			//match already has both players
+			if _, err := cardinal.GetComponent[comp.Player2](world, matchFound); err == nil {
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("%w (game_state_spawner.go): %s", ErrMatchFull, create.Msg.MatchID)
 			}
 
-			//set player2 compoenent
-			err = cardinal.SetComponent(world, matchFound,
-				&comp.Player2{
-					Nickname:    create.Tx.PersonaTag,
-					Hand:        []string{"Vampire", "LavaGolem", "Mage"},
-					Deck:        []string{"ArcherLady", "FireSpirit", "LeafBird"},
-					RemovalList: make(map[int]bool),
-					Gold:        5,
-				})
-
+			//player1 cannot join their own match as player2
+			player1, err := cardinal.GetComponent[comp.Player1](world, matchFound)
 			if err != nil {
-				// if error remove the empty player2 component
-				err = cardinal.RemoveComponentFrom[comp.Player2](world, matchFound)
-				if err != nil {
-					return msg.CreateMatchResult{Success: false}, fmt.Errorf("error adding Player2 component 2(game_state_spawner.go): %w", err)
-				}
-				return msg.CreateMatchResult{Success: false}, fmt.Errorf("error adding Player2 component 3(game_state_spawner.go): %w", err)
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("error getting player1 component (game_state_spawner.go): %w", err)
+			}
+			if player1.Nickname == create.Tx.PersonaTag {
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("%w (game_state_spawner.go): %s", ErrAlreadyInMatch, create.Tx.PersonaTag)
 			}
 
-			// get spatial hash for collision map
-			teamStateID, hash, err := getCollisionHashAndGameState(world, &comp.MatchId{MatchId: create.Msg.MatchID})
+			//both players play on the map player1 created the match with
+			matchMap, err := cardinal.GetComponent[comp.MapName](world, matchFound)
 			if err != nil {
-				return msg.CreateMatchResult{Success: false}, fmt.Errorf("error getting hash component (game_state_spawner.go): %v", err)
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("error getting map name component (game_state_spawner.go): %w", err)
+			}
+			if matchMap.MapName != create.Msg.MapName {
+				return msg.CreateMatchResult{Success: false}, fmt.Errorf("%w (game_state_spawner.go): %s", ErrMapMismatch, create.Msg.MapName)
 			}
 
-			//spawn bases
-			err = spawnBasesGSS(world, create.Msg.MatchID, teamStateID, create.Msg.MapName, hash)
-			if err != nil {
+			if err := addPlayer2GSS(world, matchFound, create.Msg.MatchID, create.Msg.MapName, create.Tx.PersonaTag, create.Msg.Deck, r.recordedTxHash(create.Hash)); err != nil {
 				return msg.CreateMatchResult{Success: false}, err
 			}
-			//set hash
-			err = cardinal.SetComponent(world, teamStateID, hash)
-			if err != nil {
-				return msg.CreateMatchResult{Success: false}, fmt.Errorf("error setting hash (game_state_spawner.go): %v", err)
-			}
 
 			return msg.CreateMatchResult{Success: true}, nil
 
 		})
 }
 
+// creates the game state of a match waiting on player2, cards is player1's validated deck and txHash the hash its message was recorded with
+func createGameStateGSS(world cardinal.WorldContext, matchID, mapName, personaTag string, cards []string, txHash types.TxHash) (types.EntityID, error) {
+	hand, deck := dealHand(cards)
+	gameState, err := cardinal.Create(world,
+		comp.MatchId{MatchId: matchID},
+		comp.UID{UID: 0},
+		comp.Player1{
+			Nickname: personaTag,
+			Hand:     hand,
+			Deck:     deck,
+			Gold:     5,
+		},
+		comp.SpatialHash{Cells: make(map[string]comp.SpatialCell),
+			CellSize: SpatialGridCellSize,
+			StartX:   float32(MapDataRegistry[mapName].StartX),
+			StartY:   float32(MapDataRegistry[mapName].StartY)},
+		comp.MapName{MapName: mapName},
+		comp.MatchSeed{MatchId: matchID, Seed: newMatchSeed(matchID, txHash)},
+		newMatchTimer(),
+		newMatchStats(),
+	)
+	if err != nil {
+		return gameState, fmt.Errorf("error creating match (game_state_spawner.go): %v", err)
+	}
+	if err := createMatchEvents(world, matchID); err != nil {
+		return gameState, err
+	}
+	if err := createVision(world, matchID); err != nil {
+		return gameState, err
+	}
+	if err := createReplay(world, matchID, mapName, personaTag, cards, txHash); err != nil {
+		return gameState, err
+	}
+	return gameState, nil
+}
+
+// adds player2 to a waiting game state, shuffles both decks and spawns the bases which starts the match
+func addPlayer2GSS(world cardinal.WorldContext, gameState types.EntityID, matchID, mapName, personaTag string, cards []string, txHash types.TxHash) error {
+	hand, deck := dealHand(cards)
+
+	//add player2 component
+	err := cardinal.AddComponentTo[comp.Player2](world, gameState)
+	if err != nil {
+		return fmt.Errorf("error adding Player2 component 1 (game_state_spawner.go): %w", err)
+	}
+
+	//set player2 compoenent
+	err = cardinal.SetComponent(world, gameState,
+		&comp.Player2{
+			Nickname: personaTag,
+			Hand:     hand,
+			Deck:     deck,
+			Gold:     5,
+		})
+
+	if err != nil {
+		// if error remove the empty player2 component
+		err = cardinal.RemoveComponentFrom[comp.Player2](world, gameState)
+		if err != nil {
+			return fmt.Errorf("error adding Player2 component 2(game_state_spawner.go): %w", err)
+		}
+		return fmt.Errorf("error adding Player2 component 3(game_state_spawner.go): %w", err)
+	}
+
+	// get spatial hash for collision map
+	teamStateID, hash, err := getCollisionHashAndGameState(world, &comp.MatchId{MatchId: matchID})
+	if err != nil {
+		return fmt.Errorf("error getting hash component (game_state_spawner.go): %v", err)
+	}
+
+	//mix player2's transaction into the seed and shuffle both decks
+	err = shuffleDecksGSS(world, teamStateID, txHash)
+	if err != nil {
+		return err
+	}
+
+	//the match starts here, replays pick up the final seed
+	seed, err := cardinal.GetComponent[comp.MatchSeed](world, teamStateID)
+	if err != nil {
+		return fmt.Errorf("error getting match seed (game_state_spawner.go): %w", err)
+	}
+	err = startReplay(world, matchID, mapName, personaTag, cards, txHash, seed.Seed)
+	if err != nil {
+		return err
+	}
+
+	//spawn bases
+	err = spawnBasesGSS(world, matchID, teamStateID, mapName, hash)
+	if err != nil {
+		return err
+	}
+	//set hash
+	err = cardinal.SetComponent(world, teamStateID, hash)
+	if err != nil {
+		return fmt.Errorf("error setting hash (game_state_spawner.go): %v", err)
+	}
+	return nil
+}
+
 // spawns bases and towers for both teams
 func spawnBasesGSS(world cardinal.WorldContext, matchID string, teamStateID types.EntityID, mapName string, spatialHash *comp.SpatialHash) error {
 	// check that map name exists in map registry
@@ -133,8 +226,11 @@ func spawnBasesGSS(world cardinal.WorldContext, matchID string, teamStateID type
 		comp.Health{CurrentHP: StructureDataRegistry["Base"].Health, MaxHP: StructureDataRegistry["Base"].Health},
 		comp.Position{PositionVectorX: float32(MapDataRegistry[mapName].Bases[0][0]), PositionVectorY: float32(MapDataRegistry[mapName].Bases[0][1]), PositionVectorZ: float32(MapDataRegistry[mapName].Bases[0][2])},
 		comp.UnitRadius{UnitRadius: StructureDataRegistry["Base"].Radius},
+		comp.SightRadius{SightRadius: StructureDataRegistry["Base"].SightRadius},
+		comp.BaseStats{Damage: StructureDataRegistry["Base"].Damage, AttackRate: StructureDataRegistry["Base"].AttackRate},
+		comp.Defense{Resistances: maps.Clone(StructureDataRegistry["Base"].Resistances), DamageTaken: 1},
 		comp.State{State: "Default"},
-		comp.Attack{Combat: false, Damage: StructureDataRegistry["Base"].Damage, Rate: StructureDataRegistry["Base"].AttackRate, Frame: 0, DamageFrame: StructureDataRegistry["Base"].DamageFrame, AttackRadius: StructureDataRegistry["Base"].AttackRadius, AggroRadius: StructureDataRegistry["Base"].AggroRadius},
+		comp.Attack{Combat: false, Damage: StructureDataRegistry["Base"].Damage, DamageType: StructureDataRegistry["Base"].DamageType, Rate: StructureDataRegistry["Base"].AttackRate, Frame: 0, DamageFrame: StructureDataRegistry["Base"].DamageFrame, AttackRadius: StructureDataRegistry["Base"].AttackRadius, AggroRadius: StructureDataRegistry["Base"].AggroRadius},
 		comp.CenterOffset{CenterOffset: StructureDataRegistry["Base"].CenterOffset},
 		comp.StructureTag{},
 	)
@@ -160,8 +256,11 @@ func spawnBasesGSS(world cardinal.WorldContext, matchID string, teamStateID type
 		comp.Health{CurrentHP: StructureDataRegistry["Base"].Health, MaxHP: StructureDataRegistry["Base"].Health},
 		comp.Position{PositionVectorX: float32(MapDataRegistry[mapName].Bases[1][0]), PositionVectorY: float32(MapDataRegistry[mapName].Bases[1][1]), PositionVectorZ: float32(MapDataRegistry[mapName].Bases[1][2])},
 		comp.UnitRadius{UnitRadius: StructureDataRegistry["Base"].Radius},
+		comp.SightRadius{SightRadius: StructureDataRegistry["Base"].SightRadius},
+		comp.BaseStats{Damage: StructureDataRegistry["Base"].Damage, AttackRate: StructureDataRegistry["Base"].AttackRate},
+		comp.Defense{Resistances: maps.Clone(StructureDataRegistry["Base"].Resistances), DamageTaken: 1},
 		comp.State{State: "Default"},
-		comp.Attack{Combat: false, Damage: StructureDataRegistry["Base"].Damage, Rate: StructureDataRegistry["Base"].AttackRate, Frame: 0, DamageFrame: StructureDataRegistry["Base"].DamageFrame, AttackRadius: StructureDataRegistry["Base"].AttackRadius, AggroRadius: StructureDataRegistry["Base"].AggroRadius},
+		comp.Attack{Combat: false, Damage: StructureDataRegistry["Base"].Damage, DamageType: StructureDataRegistry["Base"].DamageType, Rate: StructureDataRegistry["Base"].AttackRate, Frame: 0, DamageFrame: StructureDataRegistry["Base"].DamageFrame, AttackRadius: StructureDataRegistry["Base"].AttackRadius, AggroRadius: StructureDataRegistry["Base"].AggroRadius},
 		comp.CenterOffset{CenterOffset: StructureDataRegistry["Base"].CenterOffset},
 		comp.StructureTag{},
 	)
@@ -189,8 +288,11 @@ func spawnBasesGSS(world cardinal.WorldContext, matchID string, teamStateID type
 			comp.Health{CurrentHP: StructureDataRegistry["Tower"].Health, MaxHP: StructureDataRegistry["Tower"].Health},
 			comp.Position{PositionVectorX: float32(MapDataRegistry[mapName].TowersBlue[i][0]), PositionVectorY: float32(MapDataRegistry[mapName].TowersBlue[i][1]), PositionVectorZ: float32(MapDataRegistry[mapName].TowersBlue[i][2])},
 			comp.UnitRadius{UnitRadius: StructureDataRegistry["Tower"].Radius},
+			comp.SightRadius{SightRadius: StructureDataRegistry["Tower"].SightRadius},
+			comp.BaseStats{Damage: StructureDataRegistry["Tower"].Damage, AttackRate: StructureDataRegistry["Tower"].AttackRate},
+			comp.Defense{Resistances: maps.Clone(StructureDataRegistry["Tower"].Resistances), DamageTaken: 1},
 			comp.State{State: "Default"},
-			comp.Attack{Combat: false, Damage: StructureDataRegistry["Tower"].Damage, Rate: StructureDataRegistry["Tower"].AttackRate, Frame: 0, DamageFrame: StructureDataRegistry["Tower"].DamageFrame, AttackRadius: StructureDataRegistry["Tower"].AttackRadius, AggroRadius: StructureDataRegistry["Tower"].AggroRadius},
+			comp.Attack{Combat: false, Damage: StructureDataRegistry["Tower"].Damage, DamageType: StructureDataRegistry["Tower"].DamageType, Rate: StructureDataRegistry["Tower"].AttackRate, Frame: 0, DamageFrame: StructureDataRegistry["Tower"].DamageFrame, AttackRadius: StructureDataRegistry["Tower"].AttackRadius, AggroRadius: StructureDataRegistry["Tower"].AggroRadius},
 			comp.CenterOffset{CenterOffset: StructureDataRegistry["Tower"].CenterOffset},
 			comp.StructureTag{},
 		)
@@ -216,8 +318,11 @@ func spawnBasesGSS(world cardinal.WorldContext, matchID string, teamStateID type
 			comp.Health{CurrentHP: StructureDataRegistry["Tower"].Health, MaxHP: StructureDataRegistry["Tower"].Health},
 			comp.Position{PositionVectorX: float32(MapDataRegistry[mapName].TowersRed[i][0]), PositionVectorY: float32(MapDataRegistry[mapName].TowersRed[i][1]), PositionVectorZ: float32(MapDataRegistry[mapName].TowersRed[i][2])},
 			comp.UnitRadius{UnitRadius: StructureDataRegistry["Tower"].Radius},
+			comp.SightRadius{SightRadius: StructureDataRegistry["Tower"].SightRadius},
+			comp.BaseStats{Damage: StructureDataRegistry["Tower"].Damage, AttackRate: StructureDataRegistry["Tower"].AttackRate},
+			comp.Defense{Resistances: maps.Clone(StructureDataRegistry["Tower"].Resistances), DamageTaken: 1},
 			comp.State{State: "Default"},
-			comp.Attack{Combat: false, Damage: StructureDataRegistry["Tower"].Damage, Rate: StructureDataRegistry["Tower"].AttackRate, Frame: 0, DamageFrame: StructureDataRegistry["Tower"].DamageFrame, AttackRadius: StructureDataRegistry["Tower"].AttackRadius, AggroRadius: StructureDataRegistry["Tower"].AggroRadius},
+			comp.Attack{Combat: false, Damage: StructureDataRegistry["Tower"].Damage, DamageType: StructureDataRegistry["Tower"].DamageType, Rate: StructureDataRegistry["Tower"].AttackRate, Frame: 0, DamageFrame: StructureDataRegistry["Tower"].DamageFrame, AttackRadius: StructureDataRegistry["Tower"].AttackRadius, AggroRadius: StructureDataRegistry["Tower"].AggroRadius},
 			comp.CenterOffset{CenterOffset: StructureDataRegistry["Tower"].CenterOffset},
 			comp.StructureTag{},
 		)
@@ -250,7 +355,7 @@ func getNextUID(world cardinal.WorldContext, matchID string) (int, error) {
 	})
 
 	gameStateSearch := cardinal.NewSearch().Entity(
-		filter.Exact(GameStateFilters())).
+		filter.Exact(GameStateFilters()...)).
 		Where(matchFilter)
 	//game state
 	gameState, err := gameStateSearch.First(world)
@@ -304,7 +409,7 @@ func getGameStateGSS(world cardinal.WorldContext, mID *comp.MatchId) (types.Enti
 		return m.MatchId == mID.MatchId
 	})
 	foundTeam, err := cardinal.NewSearch().Entity(
-		filter.Exact(GameStateFilters())).
+		filter.Exact(GameStateFilters()...)).
 		Where(teamFilter).First(world)
 
 	if err != nil {
@@ -326,7 +431,7 @@ func getCollisionHashGSS(world cardinal.WorldContext, mID *comp.MatchId) (*comp.
 		return m.MatchId == mID.MatchId
 	})
 	foundTeam, err := cardinal.NewSearch().Entity(
-		filter.Exact(GameStateFilters())).
+		filter.Exact(GameStateFilters()...)).
 		Where(teamFilter).First(world)
 
 	if err != nil {
@@ -351,7 +456,7 @@ func getCollisionHashAndGameState(world cardinal.WorldContext, mID *comp.MatchId
 		return m.MatchId == mID.MatchId
 	})
 	gameStateID, err := cardinal.NewSearch().Entity(
-		filter.Exact(GameStateFilters())).
+		filter.Exact(GameStateFilters()...)).
 		Where(gameFilter).First(world)
 
 	if err != nil {
@@ -369,6 +474,17 @@ func getCollisionHashAndGameState(world cardinal.WorldContext, mID *comp.MatchId
 	return gameStateID, collisionHash, nil
 }
 
-func GameStateFilters() (filter.ComponentWrapper, filter.ComponentWrapper, filter.ComponentWrapper, filter.ComponentWrapper, filter.ComponentWrapper) {
-	return filter.Component[comp.MatchId](), filter.Component[comp.UID](), filter.Component[comp.Player1](), filter.Component[comp.Player2](), filter.Component[comp.SpatialHash]()
+// components making up a started match's game state
+func GameStateFilters() []filter.ComponentWrapper {
+	return []filter.ComponentWrapper{
+		filter.Component[comp.MatchId](),
+		filter.Component[comp.UID](),
+		filter.Component[comp.Player1](),
+		filter.Component[comp.Player2](),
+		filter.Component[comp.SpatialHash](),
+		filter.Component[comp.MapName](),
+		filter.Component[comp.MatchSeed](),
+		filter.Component[comp.MatchTimer](),
+		filter.Component[comp.MatchStats](),
+	}
 }
@@ -22,10 +22,8 @@ type MapData struct {
 	numTowers  int
 }
 
-// Maps
-var MapDataRegistry = map[string]MapData{
-	"ProtoType": {StartX: -5440, StartY: -3660, EndX: 5260, EndY: 4640, Increment: 100, Bases: [][]int{{3860, 500, 100}, {-3680, 700, 100}}, TowersBlue: [][]int{{1920, -1140, 100}}, TowersRed: [][]int{{-2150, 2310, 100}}, numTowers: 1},
-}
+// Maps (filled from the map files by LoadMaps)
+var MapDataRegistry = map[string]MapData{}
 
 // Normalize coords to the key required to acsess map data
 func normalizeMapCoords(x, y float32, startX, startY, increment int) string {
@@ -37,7 +35,8 @@ func normalizeMapCoords(x, y float32, startX, startY, increment int) string {
 	return fmt.Sprintf("%d,%d", normalizedX, normalizedY)
 }
 
-func getMapDirection(x, y float32, mapName string) ([]float32, error) {
+// direction vector of the teams flow field for the unit class at (x, y), leads towards the enemy base
+func getMapDirection(x, y float32, mapName, team, class string) ([]float32, error) {
 	//check map data exsists
 	mapData, exists := MapDataRegistry[mapName]
 	if !exists {
@@ -52,16 +51,22 @@ func getMapDirection(x, y float32, mapName string) ([]float32, error) {
 	//The units (x,y) coordinates normalized and turned into proper key(string) format for seaching map
 	coordKey := normalizeMapCoords(x, y, mapData.StartX, mapData.StartY, mapData.Increment)
 
+	//check the movement layer and team have a flow field
+	field, ok := mapDir.Layers[movementLayer(class)].Fields[team]
+	if !ok {
+		return nil, fmt.Errorf("error no direction field for team %s (getMapDirection)", team)
+	}
+
 	// Retrieve direction vector using coordinate key
-	directionVector, exists := mapDir.DMap[coordKey]
+	directionVector, exists := field[coordKey]
 	if !exists {
 		return nil, fmt.Errorf("no direction vector found for the given coordinates (getMapDirection)")
 	}
 	return directionVector, nil
 }
 
-// Moves Unit in direction of the map Direction vector
-func moveDirectionExsist(x, y float32, mapName string) bool {
+// checks the unit class can stand at (x, y) on the map
+func moveDirectionExsist(x, y float32, mapName, class string) bool {
 	//check map data exsists
 	mapData, exists := MapDataRegistry[mapName]
 	if !exists {
@@ -78,9 +83,8 @@ func moveDirectionExsist(x, y float32, mapName string) bool {
 	//The units (x,y) coordinates normalized and turned into proper key(string) format for seaching map
 	coordKey := normalizeMapCoords(x, y, mapData.StartX, mapData.StartY, mapData.Increment)
 
-	// Retrieve direction vector using coordinate key
-	_, exists = mapDir.DMap[coordKey]
-	if !exists {
+	// check the cell is walkable for the units movement layer
+	if !mapDir.Layers[movementLayer(class)].Walkable[coordKey] {
 		fmt.Printf("no direction vector found for the given coordinates (MoveUnitDirectionMap)\n")
 		return false
 	}
@@ -0,0 +1,318 @@
+package system
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// version of the map file layout this loader understands
+const MapFormatVersion = 3
+
+// teams that need a flow field in every map file, each field leads to the other teams base
+var MapTeams = []string{"Blue", "Red"}
+
+// movement layers every map file has flow fields for. ground units (melee, range) use the walkable mask,
+// air units use the flyable mask so they can cross terrain that blocks ground units
+var MapLayers = []string{"ground", "air"}
+
+// walkable mask characters, one per grid cell
+const (
+	MapCellWalkable byte = '.'
+	MapCellBlocked  byte = '#'
+)
+
+type MapGrid struct {
+	StartX    int `json:"startX"`
+	StartY    int `json:"startY"`
+	EndX      int `json:"endX"`
+	EndY      int `json:"endY"`
+	Increment int `json:"increment"`
+}
+
+// on disk layout of a map file. the masks and flow fields are stored row by row,
+// row 0 is startY and column 0 is startX, blocked cells have a null direction.
+// flyable is optional, without it every cell is open to air units.
+// fields are generated from the masks and bases by cmd/flowfield
+type MapFile struct {
+	FormatVersion int                                 `json:"formatVersion"`
+	Name          string                              `json:"name"`
+	Grid          MapGrid                             `json:"grid"`
+	Bases         [][]int                             `json:"bases"` //[0=Blue 1= red][x, y, z]
+	TowersBlue    [][]int                             `json:"towersBlue"`
+	TowersRed     [][]int                             `json:"towersRed"`
+	Walkable      []string                            `json:"walkable"`
+	Flyable       []string                            `json:"flyable,omitempty"`
+	Fields        map[string]map[string][][][]float32 `json:"fields,omitempty"` //layer: team: rows of {dirX, dirY} towards the enemy base
+}
+
+// number of columns and rows in the grid
+func (g MapGrid) Size() (int, int) {
+	return (g.EndX-g.StartX)/g.Increment + 1, (g.EndY-g.StartY)/g.Increment + 1
+}
+
+// column and row of the cell containing (x, y), false if it is outside the grid
+func (g MapGrid) Cell(x, y int) (int, int, bool) {
+	if x < g.StartX || x > g.EndX || y < g.StartY || y > g.EndY {
+		return 0, 0, false
+	}
+	return (x - g.StartX) / g.Increment, (y - g.StartY) / g.Increment, true
+}
+
+// mask of the cells a movement layer can occupy
+func (f *MapFile) LayerMask(layer string) []string {
+	if layer == "air" {
+		if f.Flyable != nil {
+			return f.Flyable
+		}
+		cols, rows := f.Grid.Size()
+		open := make([]string, rows)
+		for row := range open {
+			open[row] = strings.Repeat(string(MapCellWalkable), cols)
+		}
+		return open
+	}
+	return f.Walkable
+}
+
+// loads every *.json map file in dir, validates them and replaces the map data and direction map registries
+func LoadMaps(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return fmt.Errorf("error listing map files in %s (map_loader.go): %w", dir, err)
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no map files found in %s (map_loader.go)", dir)
+	}
+	sort.Strings(paths)
+
+	mapData := make(map[string]MapData, len(paths))
+	mapDirs := make(map[string]DMap, len(paths))
+	for _, path := range paths {
+		data, dirs, name, err := loadMapFile(path)
+		if err != nil {
+			return err
+		}
+		if _, exists := mapData[name]; exists {
+			return fmt.Errorf("map %s defined more than once, second definition in %s (map_loader.go)", name, path)
+		}
+		mapData[name] = data
+		mapDirs[name] = dirs
+	}
+
+	MapDataRegistry = mapData
+	MapRegistry = mapDirs
+	return nil
+}
+
+// decodes a map file without validating it
+func ReadMapFile(path string) (*MapFile, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading map file %s (map_loader.go): %w", path, err)
+	}
+
+	var file MapFile
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("error decoding map file %s (map_loader.go): %w", path, err)
+	}
+	return &file, nil
+}
+
+// reads a single map file and converts it into the registry formats
+func loadMapFile(path string) (MapData, DMap, string, error) {
+	file, err := ReadMapFile(path)
+	if err != nil {
+		return MapData{}, DMap{}, "", err
+	}
+
+	if err := ValidateMapFile(file); err != nil {
+		return MapData{}, DMap{}, "", fmt.Errorf("invalid map file %s (map_loader.go): %w", path, err)
+	}
+
+	data := MapData{
+		StartX:     file.Grid.StartX,
+		StartY:     file.Grid.StartY,
+		EndX:       file.Grid.EndX,
+		EndY:       file.Grid.EndY,
+		Increment:  file.Grid.Increment,
+		Bases:      file.Bases,
+		TowersBlue: file.TowersBlue,
+		TowersRed:  file.TowersRed,
+		numTowers:  len(file.TowersBlue),
+	}
+
+	dirs := DMap{Layers: make(map[string]DMapLayer, len(MapLayers))}
+	for _, layer := range MapLayers {
+		dirLayer := DMapLayer{Walkable: make(map[string]bool), Fields: make(map[string]map[string][]float32, len(MapTeams))}
+		for row, mask := range file.LayerMask(layer) {
+			for col := range mask {
+				if mask[col] == MapCellWalkable {
+					dirLayer.Walkable[gridKey(file.Grid, col, row)] = true
+				}
+			}
+		}
+		for _, team := range MapTeams {
+			field := make(map[string][]float32, len(dirLayer.Walkable))
+			for row, directions := range file.Fields[layer][team] {
+				for col, direction := range directions {
+					if direction != nil {
+						field[gridKey(file.Grid, col, row)] = direction
+					}
+				}
+			}
+			dirLayer.Fields[team] = field
+		}
+		dirs.Layers[layer] = dirLayer
+	}
+
+	return data, dirs, file.Name, nil
+}
+
+// registry key "x,y" of a grid cell
+func gridKey(grid MapGrid, col, row int) string {
+	return fmt.Sprintf("%d,%d", grid.StartX+col*grid.Increment, grid.StartY+row*grid.Increment)
+}
+
+// checks the layout (grid, masks, bases and towers) and that every layer has a flow field per team matching its mask
+func ValidateMapFile(file *MapFile) error {
+	if file.FormatVersion != MapFormatVersion {
+		return fmt.Errorf("unsupported formatVersion %d, expected %d", file.FormatVersion, MapFormatVersion)
+	}
+	if err := ValidateMapLayout(file); err != nil {
+		return err
+	}
+
+	cols, rows := file.Grid.Size()
+	var errs []error
+	for _, layer := range MapLayers {
+		mask := file.LayerMask(layer)
+		for _, team := range MapTeams {
+			field, ok := file.Fields[layer][team]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s flow field for team %s is missing", layer, team))
+				continue
+			}
+			if len(field) != rows {
+				errs = append(errs, fmt.Errorf("%s %s field has %d rows, grid has %d", layer, team, len(field), rows))
+				continue
+			}
+			for row := 0; row < rows; row++ {
+				if len(field[row]) != cols {
+					errs = append(errs, fmt.Errorf("%s %s field row %d has %d directions, grid has %d columns", layer, team, row, len(field[row]), cols))
+					continue
+				}
+				for col := 0; col < cols; col++ {
+					open := mask[row][col] == MapCellWalkable
+					if open && len(field[row][col]) != 2 {
+						errs = append(errs, fmt.Errorf("%s %s field open cell row %d col %d needs a [x, y] direction", layer, team, row, col))
+					} else if !open && field[row][col] != nil {
+						errs = append(errs, fmt.Errorf("%s %s field blocked cell row %d col %d has a direction", layer, team, row, col))
+					}
+				}
+			}
+		}
+	}
+	for layer, teams := range file.Fields {
+		if !slices.Contains(MapLayers, layer) {
+			errs = append(errs, fmt.Errorf("flow fields for unknown layer %s", layer))
+			continue
+		}
+		for team := range teams {
+			if !slices.Contains(MapTeams, team) {
+				errs = append(errs, fmt.Errorf("%s flow field for unknown team %s", layer, team))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checks grid metadata and the masks, and that every base and tower sits on a cell open to every layer.
+// this is everything a map designer writes by hand, the flow fields are generated from it
+func ValidateMapLayout(file *MapFile) error {
+	if file.Name == "" {
+		return errors.New("name is missing")
+	}
+
+	grid := file.Grid
+	if grid.Increment <= 0 || grid.EndX <= grid.StartX || grid.EndY <= grid.StartY ||
+		(grid.EndX-grid.StartX)%grid.Increment != 0 || (grid.EndY-grid.StartY)%grid.Increment != 0 {
+		return fmt.Errorf("grid %+v must have a positive increment that evenly divides end - start", grid)
+	}
+
+	if err := validateMapMask("walkable", file.Walkable, grid); err != nil {
+		return err
+	}
+	if file.Flyable != nil {
+		if err := validateMapMask("flyable", file.Flyable, grid); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	if len(file.Bases) != 2 {
+		errs = append(errs, fmt.Errorf("map needs exactly 2 bases, found %d", len(file.Bases)))
+	}
+	if len(file.TowersBlue) != len(file.TowersRed) {
+		errs = append(errs, fmt.Errorf("towersBlue has %d towers and towersRed has %d, teams must match", len(file.TowersBlue), len(file.TowersRed)))
+	}
+
+	checkSpawn := func(kind string, i int, pos []int) {
+		if len(pos) != 3 {
+			errs = append(errs, fmt.Errorf("%s %d must be [x, y, z]", kind, i))
+			return
+		}
+		x, y := pos[0], pos[1]
+		col, row, ok := grid.Cell(x, y)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s %d at (%d, %d) is outside the direction grid", kind, i, x, y))
+			return
+		}
+		for _, layer := range MapLayers {
+			if file.LayerMask(layer)[row][col] != MapCellWalkable {
+				errs = append(errs, fmt.Errorf("%s %d at (%d, %d) sits on a cell blocked for %s units", kind, i, x, y, layer))
+			}
+		}
+	}
+	for i, pos := range file.Bases {
+		checkSpawn("base", i, pos)
+	}
+	for i, pos := range file.TowersBlue {
+		checkSpawn("towersBlue", i, pos)
+	}
+	for i, pos := range file.TowersRed {
+		checkSpawn("towersRed", i, pos)
+	}
+
+	return errors.Join(errs...)
+}
+
+// checks a mask covers the grid and only uses the mask characters
+func validateMapMask(name string, mask []string, grid MapGrid) error {
+	cols, rows := grid.Size()
+	if len(mask) != rows {
+		return fmt.Errorf("%s has %d rows, grid has %d", name, len(mask), rows)
+	}
+
+	var errs []error
+	for row := 0; row < rows; row++ {
+		if len(mask[row]) != cols {
+			errs = append(errs, fmt.Errorf("%s row %d has %d cells, grid has %d columns", name, row, len(mask[row]), cols))
+			continue
+		}
+		for col := 0; col < cols; col++ {
+			if mask[row][col] != MapCellWalkable && mask[row][col] != MapCellBlocked {
+				errs = append(errs, fmt.Errorf("%s row %d col %d has unknown character %q", name, row, col, mask[row][col]))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
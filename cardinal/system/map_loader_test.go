@@ -0,0 +1,132 @@
+package system
+
+import (
+	"strings"
+	"testing"
+)
+
+// 3x3 map with open masks, both bases in opposite corners and a flow field per layer and team
+func testMapFile() *MapFile {
+	file := &MapFile{
+		FormatVersion: MapFormatVersion,
+		Name:          "Test",
+		Grid:          MapGrid{StartX: 0, StartY: 0, EndX: 200, EndY: 200, Increment: 100},
+		Bases:         [][]int{{0, 0, 0}, {200, 200, 0}},
+		TowersBlue:    [][]int{{100, 0, 0}},
+		TowersRed:     [][]int{{100, 200, 0}},
+		Walkable:      []string{"...", "...", "..."},
+		Fields:        make(map[string]map[string][][][]float32),
+	}
+	for _, layer := range MapLayers {
+		file.Fields[layer] = make(map[string][][][]float32)
+		for _, team := range MapTeams {
+			field := make([][][]float32, 3)
+			for row := range field {
+				field[row] = [][]float32{{1, 0}, {1, 0}, {1, 0}}
+			}
+			file.Fields[layer][team] = field
+		}
+	}
+	return file
+}
+
+func TestValidateMapLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(file *MapFile)
+		wantErr string //substring of the error, empty for a valid layout
+	}{
+		{"valid", func(file *MapFile) {}, ""},
+		{"missing name", func(file *MapFile) { file.Name = "" }, "name is missing"},
+		{"zero increment", func(file *MapFile) { file.Grid.Increment = 0 }, "positive increment"},
+		{"increment not dividing", func(file *MapFile) { file.Grid.Increment = 90 }, "evenly divides"},
+		{"end before start", func(file *MapFile) { file.Grid.EndX = -100 }, "positive increment"},
+		{"mask rows", func(file *MapFile) { file.Walkable = file.Walkable[:2] }, "walkable has 2 rows, grid has 3"},
+		{"mask columns", func(file *MapFile) { file.Walkable[1] = ".." }, "walkable row 1 has 2 cells"},
+		{"mask character", func(file *MapFile) { file.Walkable[2] = ".x." }, "unknown character 'x'"},
+		{"flyable rows", func(file *MapFile) { file.Flyable = []string{"..."} }, "flyable has 1 rows"},
+		{"one base", func(file *MapFile) { file.Bases = file.Bases[:1] }, "exactly 2 bases, found 1"},
+		{"uneven towers", func(file *MapFile) { file.TowersRed = nil }, "towersBlue has 1 towers and towersRed has 0"},
+		{"base not xyz", func(file *MapFile) { file.Bases[1] = []int{200, 200} }, "base 1 must be [x, y, z]"},
+		{"base outside grid", func(file *MapFile) { file.Bases[0] = []int{-50, 0, 0} }, "outside the direction grid"},
+		{"tower on blocked ground", func(file *MapFile) { file.Walkable[0] = ".#." }, "towersBlue 0 at (100, 0) sits on a cell blocked for ground units"},
+		{"tower on blocked air", func(file *MapFile) { file.Flyable = []string{"...", "...", ".#."} }, "towersRed 0 at (100, 200) sits on a cell blocked for air units"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := testMapFile()
+			tt.edit(file)
+			err := ValidateMapLayout(file)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateMapLayout() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateMapLayout() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateMapFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(file *MapFile)
+		wantErr string //substring of the error, empty for a valid map
+	}{
+		{"valid", func(file *MapFile) {}, ""},
+		{"format version", func(file *MapFile) { file.FormatVersion = MapFormatVersion - 1 }, "unsupported formatVersion"},
+		{"layout checked first", func(file *MapFile) { file.Name = "" }, "name is missing"},
+		{"missing team field", func(file *MapFile) { delete(file.Fields["air"], "Red") }, "air flow field for team Red is missing"},
+		{"missing layer", func(file *MapFile) { delete(file.Fields, "ground") }, "ground flow field for team Blue is missing"},
+		{"field rows", func(file *MapFile) { file.Fields["ground"]["Blue"] = file.Fields["ground"]["Blue"][:2] }, "ground Blue field has 2 rows, grid has 3"},
+		{"field columns", func(file *MapFile) { file.Fields["ground"]["Red"][0] = [][]float32{{1, 0}} }, "ground Red field row 0 has 1 directions"},
+		{"open cell without direction", func(file *MapFile) { file.Fields["air"]["Blue"][1][1] = nil }, "air Blue field open cell row 1 col 1 needs a [x, y] direction"},
+		{"blocked cell with direction", func(file *MapFile) { file.Walkable[1] = ".#." }, "ground Blue field blocked cell row 1 col 1 has a direction"},
+		{"unknown layer", func(file *MapFile) { file.Fields["water"] = nil }, "flow fields for unknown layer water"},
+		{"unknown team", func(file *MapFile) { file.Fields["ground"]["Green"] = nil }, "ground flow field for unknown team Green"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := testMapFile()
+			tt.edit(file)
+			err := ValidateMapFile(file)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateMapFile() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateMapFile() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLayerMask(t *testing.T) {
+	file := testMapFile()
+	file.Walkable = []string{".#.", "...", "..."}
+	if got := file.LayerMask("ground"); got[0] != ".#." {
+		t.Errorf("ground mask row 0 = %q, want the walkable mask", got[0])
+	}
+	//without a flyable mask air units can go everywhere
+	if got := file.LayerMask("air"); len(got) != 3 || got[0] != "..." {
+		t.Errorf("air mask = %q, want every cell open", got)
+	}
+	file.Flyable = []string{"#..", "...", "..."}
+	if got := file.LayerMask("air"); got[0] != "#.." {
+		t.Errorf("air mask row 0 = %q, want the flyable mask", got[0])
+	}
+}
+
+func TestShippedMapsLoad(t *testing.T) {
+	if err := LoadMaps("../data/maps"); err != nil {
+		t.Fatalf("LoadMaps() = %v", err)
+	}
+	if _, ok := MapDataRegistry["ProtoType"]; !ok {
+		t.Errorf("ProtoType map not registered")
+	}
+}
@@ -1,13 +1,23 @@
 package system
 
+// direction maps of a single map per movement layer ("ground", "air")
 type DMap struct {
-	DMap map[string][]float32 `json:"dirmap"`
+	Layers map[string]DMapLayer `json:"layers"`
 }
 
-// map partition with direction vectors "x,y": {dirX, dirY}
-var MapRegistry = map[string]DMap{
-	"ProtoType": {DMap: map[string][]float32{
-		"-4840,-360": {-0.171, 0.985}, "-4840,-260": {-0.029, 1.000}, "-4840,-160": {-0.029, 1.000}, "-4840,-60": {-0.029, 1.000}, "-4840,40": {-0.029, 1.000}, "-4840,140": {-0.029, 1.000}, "-4840,240": {-0.029, 1.000}, "-4840,340": {0.000, 1.000}, "-4840,440": {0.000, 1.000}, "-4840,540": {0.000, 1.000}, "-4840,640": {0.000, 1.000}, "-4840,740": {0.000, 1.000}, "-4840,840": {0.000, 1.000}, "-4840,940": {-0.035, -0.999}, "-4840,1040": {-0.035, -0.999}, "-4840,1140": {-0.035, -0.999}, "-4840,1240": {-0.035, -0.999}, "-4840,1340": {-0.035, -0.999}, "-4840,1440": {-0.191, -0.982}, "-4840,1540": {-0.191, -0.982}, "-4840,1640": {-0.191, -0.982}, "-4840,1740": {-0.191, -0.982}, "-4840,1840": {-0.191, -0.982}, "-4740,-660": {-0.413, 0.911}, "-4740,-560": {-0.171, 0.985}, "-4740,-460": {-0.171, 0.985}, "-4740,-360": {-0.171, 0.985}, "-4740,-260": {-0.029, 1.000}, "-4740,-160": {-0.029, 1.000}, "-4740,-60": {-0.029, 1.000}, "-4740,40": {-0.029, 1.000}, "-4740,140": {-0.029, 1.000}, "-4740,240": {-0.029, 1.000}, "-4740,340": {0.000, 1.000}, "-4740,440": {0.000, 1.000}, "-4740,540": {0.000, 1.000}, "-4740,640": {0.000, 1.000}, "-4740,740": {0.000, 1.000}, "-4740,840": {0.000, 1.000}, "-4740,940": {-0.035, -0.999}, "-4740,1040": {-0.035, -0.999}, "-4740,1140": {-0.035, -0.999}, "-4740,1240": {-0.035, -0.999}, "-4740,1340": {-0.035, -0.999}, "-4740,1440": {-0.191, -0.982}, "-4740,1540": {-0.191, -0.982}, "-4740,1640": {-0.191, -0.982}, "-4740,1740": {-0.191, -0.982}, "-4740,1840": {-0.191, -0.982}, "-4740,1940": {-0.191, -0.982}, "-4740,2040": {-0.444, -0.896}, "-4640,-860": {-0.413, 0.911}, "-4640,-760": {-0.413, 0.911}, "-4640,-660": {-0.413, 0.911}, "-4640,-560": {-0.413, 0.911}, "-4640,-460": {-0.171, 0.985}, "-4640,-360": {-0.171, 0.985}, "-4640,-260": {-0.171, 0.985}, "-4640,-160": {-0.029, 1.000}, "-4640,-60": {-0.029, 1.000}, "-4640,40": {-0.029, 1.000}, "-4640,140": {-0.029, 1.000}, "-4640,240": {-0.029, 1.000}, "-4640,340": {0.000, 1.000}, "-4640,440": {0.000, 1.000}, "-4640,540": {0.000, 1.000}, "-4640,640": {0.000, 1.000}, "-4640,740": {0.000, 1.000}, "-4640,840": {0.000, 1.000}, "-4640,940": {-0.035, -0.999}, "-4640,1040": {-0.035, -0.999}, "-4640,1140": {-0.035, -0.999}, "-4640,1240": {-0.035, -0.999}, "-4640,1340": {-0.035, -0.999}, "-4640,1440": {-0.191, -0.982}, "-4640,1540": {-0.191, -0.982}, "-4640,1640": {-0.191, -0.982}, "-4640,1740": {-0.191, -0.982}, "-4640,1840": {-0.191, -0.982}, "-4640,1940": {-0.444, -0.896}, "-4640,2040": {-0.444, -0.896}, "-4640,2140": {-0.444, -0.896}, "-4640,2240": {-0.444, -0.896}, "-4540,-1060": {-0.609, 0.793}, "-4540,-960": {-0.609, 0.793}, "-4540,-860": {-0.609, 0.793}, "-4540,-760": {-0.413, 0.911}, "-4540,-660": {-0.413, 0.911}, "-4540,-560": {-0.413, 0.911}, "-4540,-460": {-0.171, 0.985}, "-4540,-360": {-0.171, 0.985}, "-4540,-260": {-0.171, 0.985}, "-4540,-160": {-0.029, 1.000}, "-4540,-60": {-0.029, 1.000}, "-4540,40": {-0.029, 1.000}, "-4540,140": {-0.029, 1.000}, "-4540,240": {-0.029, 1.000}, "-4540,340": {0.000, 1.000}, "-4540,440": {0.000, 1.000}, "-4540,540": {0.000, 1.000}, "-4540,640": {0.000, 1.000}, "-4540,740": {0.000, 1.000}, "-4540,840": {0.000, 1.000}, "-4540,940": {-0.035, -0.999}, "-4540,1040": {-0.035, -0.999}, "-4540,1140": {-0.035, -0.999}, "-4540,1240": {-0.035, -0.999}, "-4540,1340": {-0.035, -0.999}, "-4540,1440": {-0.191, -0.982}, "-4540,1540": {-0.191, -0.982}, "-4540,1640": {-0.191, -0.982}, "-4540,1740": {-0.191, -0.982}, "-4540,1840": {-0.191, -0.982}, "-4540,1940": {-0.444, -0.896}, "-4540,2040": {-0.444, -0.896}, "-4540,2140": {-0.444, -0.896}, "-4540,2240": {-0.444, -0.896}, "-4540,2340": {-0.444, -0.896}, "-4440,-1160": {-0.609, 0.793}, "-4440,-1060": {-0.609, 0.793}, "-4440,-960": {-0.609, 0.793}, "-4440,-860": {-0.609, 0.793}, "-4440,-760": {-0.413, 0.911}, "-4440,-660": {-0.413, 0.911}, "-4440,-560": {-0.413, 0.911}, "-4440,-460": {-0.171, 0.985}, "-4440,-360": {-0.171, 0.985}, "-4440,-260": {-0.171, 0.985}, "-4440,-160": {-0.029, 1.000}, "-4440,-60": {-0.029, 1.000}, "-4440,40": {-0.029, 1.000}, "-4440,140": {-0.029, 1.000}, "-4440,240": {-0.029, 1.000}, "-4440,340": {0.000, 1.000}, "-4440,440": {0.000, 1.000}, "-4440,540": {0.000, 1.000}, "-4440,640": {0.000, 1.000}, "-4440,740": {0.000, 1.000}, "-4440,840": {0.000, 1.000}, "-4440,940": {-0.035, -0.999}, "-4440,1040": {-0.035, -0.999}, "-4440,1140": {-0.035, -0.999}, "-4440,1240": {-0.035, -0.999}, "-4440,1340": {-0.035, -0.999}, "-4440,1440": {-0.191, -0.982}, "-4440,1540": {-0.191, -0.982}, "-4440,1640": {-0.191, -0.982}, "-4440,1740": {-0.191, -0.982}, "-4440,1840": {-0.191, -0.982}, "-4440,1940": {-0.444, -0.896}, "-4440,2040": {-0.444, -0.896}, "-4440,2140": {-0.444, -0.896}, "-4440,2240": {-0.444, -0.896}, "-4440,2340": {-0.444, -0.896}, "-4440,2440": {-0.444, -0.896}, "-4440,2540": {-0.649, -0.761}, "-4340,-1260": {-0.780, 0.626}, "-4340,-1160": {-0.609, 0.793}, "-4340,-1060": {-0.609, 0.793}, "-4340,-960": {-0.609, 0.793}, "-4340,-860": {-0.609, 0.793}, "-4340,-760": {-0.609, 0.793}, "-4340,-660": {-0.413, 0.911}, "-4340,-560": {-0.413, 0.911}, "-4340,-460": {-0.171, 0.985}, "-4340,-360": {-0.171, 0.985}, "-4340,-260": {-0.171, 0.985}, "-4340,-160": {-0.029, 1.000}, "-4340,-60": {-0.029, 1.000}, "-4340,40": {-0.029, 1.000}, "-4340,140": {-0.029, 1.000}, "-4340,240": {-0.029, 1.000}, "-4340,340": {0.000, 1.000}, "-4340,440": {0.000, 1.000}, "-4340,540": {0.000, 1.000}, "-4340,640": {0.000, 1.000}, "-4340,740": {0.000, 1.000}, "-4340,840": {0.000, 1.000}, "-4340,940": {-0.035, -0.999}, "-4340,1040": {-0.035, -0.999}, "-4340,1140": {-0.035, -0.999}, "-4340,1240": {-0.035, -0.999}, "-4340,1340": {-0.035, -0.999}, "-4340,1440": {-0.191, -0.982}, "-4340,1540": {-0.191, -0.982}, "-4340,1640": {-0.191, -0.982}, "-4340,1740": {-0.191, -0.982}, "-4340,1840": {-0.444, -0.896}, "-4340,1940": {-0.444, -0.896}, "-4340,2040": {-0.444, -0.896}, "-4340,2140": {-0.444, -0.896}, "-4340,2240": {-0.444, -0.896}, "-4340,2340": {-0.444, -0.896}, "-4340,2440": {-0.649, -0.761}, "-4340,2540": {-0.649, -0.761}, "-4340,2640": {-0.649, -0.761}, "-4340,2740": {-0.649, -0.761}, "-4240,-1360": {-0.780, 0.626}, "-4240,-1260": {-0.780, 0.626}, "-4240,-1160": {-0.780, 0.626}, "-4240,-1060": {-0.609, 0.793}, "-4240,-960": {-0.609, 0.793}, "-4240,-860": {-0.609, 0.793}, "-4240,-760": {-0.609, 0.793}, "-4240,-660": {-0.609, 0.793}, "-4240,-560": {-0.413, 0.911}, "-4240,-460": {-0.413, 0.911}, "-4240,-360": {-0.168, 0.986}, "-4240,-260": {-0.168, 0.986}, "-4240,-160": {-0.168, 0.986}, "-4240,-60": {-0.025, 1.000}, "-4240,40": {-0.025, 1.000}, "-4240,140": {-0.000, 1.000}, "-4240,240": {-0.000, 1.000}, "-4240,340": {-0.000, 1.000}, "-4240,440": {-0.000, 1.000}, "-4240,540": {-0.000, 1.000}, "-4240,640": {-0.000, 1.000}, "-4240,740": {-0.000, 1.000}, "-4240,840": {-0.000, -1.000}, "-4240,940": {-0.000, -1.000}, "-4240,1040": {-0.000, -1.000}, "-4240,1140": {-0.042, -0.999}, "-4240,1240": {-0.042, -0.999}, "-4240,1340": {-0.042, -0.999}, "-4240,1440": {-0.252, -0.968}, "-4240,1540": {-0.252, -0.968}, "-4240,1640": {-0.191, -0.982}, "-4240,1740": {-0.191, -0.982}, "-4240,1840": {-0.444, -0.896}, "-4240,1940": {-0.444, -0.896}, "-4240,2040": {-0.444, -0.896}, "-4240,2140": {-0.444, -0.896}, "-4240,2240": {-0.444, -0.896}, "-4240,2340": {-0.649, -0.761}, "-4240,2440": {-0.649, -0.761}, "-4240,2540": {-0.649, -0.761}, "-4240,2640": {-0.649, -0.761}, "-4240,2740": {-0.649, -0.761}, "-4240,2840": {-0.649, -0.761}, "-4140,-1460": {-0.780, 0.626}, "-4140,-1360": {-0.780, 0.626}, "-4140,-1260": {-0.780, 0.626}, "-4140,-1160": {-0.780, 0.626}, "-4140,-1060": {-0.780, 0.626}, "-4140,-960": {-0.609, 0.793}, "-4140,-860": {-0.609, 0.793}, "-4140,-760": {-0.609, 0.793}, "-4140,-660": {-0.447, 0.894}, "-4140,-560": {-0.447, 0.894}, "-4140,-460": {-0.168, 0.986}, "-4140,-360": {-0.168, 0.986}, "-4140,-260": {-0.168, 0.986}, "-4140,-160": {-0.168, 0.986}, "-4140,-60": {-0.025, 1.000}, "-4140,40": {-0.025, 1.000}, "-4140,140": {-0.000, 1.000}, "-4140,240": {-0.000, 1.000}, "-4140,340": {-0.000, 1.000}, "-4140,440": {-0.000, 1.000}, "-4140,540": {-0.000, 1.000}, "-4140,640": {-0.000, 1.000}, "-4140,740": {-0.000, 1.000}, "-4140,840": {-0.000, -1.000}, "-4140,940": {-0.000, -1.000}, "-4140,1040": {-0.000, -1.000}, "-4140,1140": {-0.042, -0.999}, "-4140,1240": {-0.042, -0.999}, "-4140,1340": {-0.252, -0.968}, "-4140,1440": {-0.252, -0.968}, "-4140,1540": {-0.252, -0.968}, "-4140,1640": {-0.252, -0.968}, "-4140,1740": {-0.252, -0.968}, "-4140,1840": {-0.502, -0.865}, "-4140,1940": {-0.444, -0.896}, "-4140,2040": {-0.444, -0.896}, "-4140,2140": {-0.444, -0.896}, "-4140,2240": {-0.444, -0.896}, "-4140,2340": {-0.649, -0.761}, "-4140,2440": {-0.649, -0.761}, "-4140,2540": {-0.649, -0.761}, "-4140,2640": {-0.649, -0.761}, "-4140,2740": {-0.649, -0.761}, "-4140,2840": {-0.649, -0.761}, "-4040,-1460": {-0.780, 0.626}, "-4040,-1360": {-0.780, 0.626}, "-4040,-1260": {-0.780, 0.626}, "-4040,-1160": {-0.780, 0.626}, "-4040,-1060": {-0.780, 0.626}, "-4040,-960": {-0.780, 0.626}, "-4040,-860": {-0.609, 0.793}, "-4040,-760": {-0.447, 0.894}, "-4040,-660": {-0.447, 0.894}, "-4040,-560": {-0.447, 0.894}, "-4040,-460": {-0.447, 0.894}, "-4040,-360": {-0.168, 0.986}, "-4040,-260": {-0.168, 0.986}, "-4040,-160": {-0.168, 0.986}, "-4040,-60": {-0.025, 1.000}, "-4040,40": {-0.025, 1.000}, "-4040,140": {-0.000, 1.000}, "-4040,240": {-0.000, 1.000}, "-4040,340": {-0.000, 1.000}, "-4040,440": {-0.000, 1.000}, "-4040,540": {-0.000, 1.000}, "-4040,640": {-0.000, 1.000}, "-4040,740": {-0.000, 1.000}, "-4040,840": {-0.000, -1.000}, "-4040,940": {-0.000, -1.000}, "-4040,1040": {-0.000, -1.000}, "-4040,1140": {-0.042, -0.999}, "-4040,1240": {-0.042, -0.999}, "-4040,1340": {-0.252, -0.968}, "-4040,1440": {-0.252, -0.968}, "-4040,1540": {-0.252, -0.968}, "-4040,1640": {-0.252, -0.968}, "-4040,1740": {-0.252, -0.968}, "-4040,1840": {-0.502, -0.865}, "-4040,1940": {-0.502, -0.865}, "-4040,2040": {-0.502, -0.865}, "-4040,2140": {-0.444, -0.896}, "-4040,2240": {-0.649, -0.761}, "-4040,2340": {-0.649, -0.761}, "-4040,2440": {-0.649, -0.761}, "-4040,2540": {-0.649, -0.761}, "-4040,2640": {-0.649, -0.761}, "-4040,2740": {-0.649, -0.761}, "-4040,2840": {-0.649, -0.761}, "-4040,2940": {-0.649, -0.761}, "-3940,-1560": {-0.914, 0.405}, "-3940,-1460": {-0.914, 0.405}, "-3940,-1360": {-0.780, 0.626}, "-3940,-1260": {-0.780, 0.626}, "-3940,-1160": {-0.780, 0.626}, "-3940,-1060": {-0.780, 0.626}, "-3940,-960": {-0.780, 0.626}, "-3940,-860": {-0.699, 0.715}, "-3940,-760": {-0.447, 0.894}, "-3940,-660": {-0.447, 0.894}, "-3940,-560": {-0.447, 0.894}, "-3940,-460": {-0.447, 0.894}, "-3940,-360": {-0.168, 0.986}, "-3940,-260": {-0.168, 0.986}, "-3940,-160": {-0.168, 0.986}, "-3940,-60": {-0.025, 1.000}, "-3940,40": {-0.025, 1.000}, "-3940,140": {-0.000, 1.000}, "-3940,240": {-0.000, 1.000}, "-3940,340": {-0.000, 1.000}, "-3940,440": {-0.000, 1.000}, "-3940,540": {-0.000, 1.000}, "-3940,640": {-0.000, 1.000}, "-3940,740": {-0.000, -1.000}, "-3940,840": {-0.000, -1.000}, "-3940,940": {-0.000, -1.000}, "-3940,1040": {-0.000, -1.000}, "-3940,1140": {-0.042, -0.999}, "-3940,1240": {-0.042, -0.999}, "-3940,1340": {-0.252, -0.968}, "-3940,1440": {-0.252, -0.968}, "-3940,1540": {-0.252, -0.968}, "-3940,1640": {-0.252, -0.968}, "-3940,1740": {-0.502, -0.865}, "-3940,1840": {-0.502, -0.865}, "-3940,1940": {-0.502, -0.865}, "-3940,2040": {-0.502, -0.865}, "-3940,2140": {-0.502, -0.865}, "-3940,2240": {-0.699, -0.715}, "-3940,2340": {-0.649, -0.761}, "-3940,2440": {-0.649, -0.761}, "-3940,2540": {-0.649, -0.761}, "-3940,2640": {-0.649, -0.761}, "-3940,2740": {-0.649, -0.761}, "-3940,2840": {-0.649, -0.761}, "-3940,2940": {-0.851, -0.525}, "-3940,3040": {-0.851, -0.525}, "-3840,-1560": {-0.914, 0.405}, "-3840,-1460": {-0.914, 0.405}, "-3840,-1360": {-0.914, 0.405}, "-3840,-1260": {-0.780, 0.626}, "-3840,-1160": {-0.780, 0.626}, "-3840,-1060": {-0.699, 0.715}, "-3840,-960": {-0.699, 0.715}, "-3840,-860": {-0.699, 0.715}, "-3840,-760": {-0.447, 0.894}, "-3840,-660": {-0.447, 0.894}, "-3840,-560": {-0.447, 0.894}, "-3840,-460": {-0.447, 0.894}, "-3840,-360": {-0.168, 0.986}, "-3840,-260": {-0.168, 0.986}, "-3840,-160": {-0.168, 0.986}, "-3840,-60": {-0.025, 1.000}, "-3840,40": {-0.025, 1.000}, "-3840,140": {-0.000, 1.000}, "-3840,240": {-0.000, 1.000}, "-3840,340": {-0.000, 1.000}, "-3840,440": {-0.000, 1.000}, "-3840,540": {-0.000, 1.000}, "-3840,640": {-0.000, 1.000}, "-3840,740": {-0.000, -1.000}, "-3840,840": {-0.000, -1.000}, "-3840,940": {-0.000, -1.000}, "-3840,1040": {-0.000, -1.000}, "-3840,1140": {-0.042, -0.999}, "-3840,1240": {-0.042, -0.999}, "-3840,1340": {-0.252, -0.968}, "-3840,1440": {-0.252, -0.968}, "-3840,1540": {-0.252, -0.968}, "-3840,1640": {-0.252, -0.968}, "-3840,1740": {-0.502, -0.865}, "-3840,1840": {-0.502, -0.865}, "-3840,1940": {-0.502, -0.865}, "-3840,2040": {-0.502, -0.865}, "-3840,2140": {-0.699, -0.715}, "-3840,2240": {-0.699, -0.715}, "-3840,2340": {-0.699, -0.715}, "-3840,2440": {-0.649, -0.761}, "-3840,2540": {-0.649, -0.761}, "-3840,2640": {-0.649, -0.761}, "-3840,2740": {-0.851, -0.525}, "-3840,2840": {-0.851, -0.525}, "-3840,2940": {-0.851, -0.525}, "-3840,3040": {-0.851, -0.525}, "-3840,3140": {-0.851, -0.525}, "-3740,-1660": {-0.914, 0.405}, "-3740,-1560": {-0.914, 0.405}, "-3740,-1460": {-0.914, 0.405}, "-3740,-1360": {-0.914, 0.405}, "-3740,-1260": {-0.914, 0.405}, "-3740,-1160": {-0.914, 0.405}, "-3740,-1060": {-0.699, 0.715}, "-3740,-960": {-0.699, 0.715}, "-3740,-860": {-0.699, 0.715}, "-3740,-760": {-0.699, 0.715}, "-3740,-660": {-0.447, 0.894}, "-3740,-560": {-0.447, 0.894}, "-3740,-460": {-0.447, 0.894}, "-3740,-360": {-0.447, 0.894}, "-3740,-260": {-0.168, 0.986}, "-3740,-160": {-0.168, 0.986}, "-3740,-60": {-0.025, 1.000}, "-3740,40": {-0.025, 1.000}, "-3740,140": {-0.000, 1.000}, "-3740,240": {-0.000, 1.000}, "-3740,340": {-0.000, 1.000}, "-3740,440": {-0.000, 1.000}, "-3740,540": {-0.000, 1.000}, "-3740,640": {-0.000, -1.000}, "-3740,740": {-0.000, -1.000}, "-3740,840": {-0.000, -1.000}, "-3740,940": {-0.000, -1.000}, "-3740,1040": {-0.000, -1.000}, "-3740,1140": {-0.042, -0.999}, "-3740,1240": {-0.042, -0.999}, "-3740,1340": {-0.252, -0.968}, "-3740,1440": {-0.252, -0.968}, "-3740,1540": {-0.252, -0.968}, "-3740,1640": {-0.502, -0.865}, "-3740,1740": {-0.502, -0.865}, "-3740,1840": {-0.502, -0.865}, "-3740,1940": {-0.502, -0.865}, "-3740,2040": {-0.699, -0.715}, "-3740,2140": {-0.699, -0.715}, "-3740,2240": {-0.699, -0.715}, "-3740,2340": {-0.699, -0.715}, "-3740,2440": {-0.699, -0.715}, "-3740,2540": {-0.649, -0.761}, "-3740,2640": {-0.851, -0.525}, "-3740,2740": {-0.851, -0.525}, "-3740,2840": {-0.851, -0.525}, "-3740,2940": {-0.851, -0.525}, "-3740,3040": {-0.851, -0.525}, "-3740,3140": {-0.851, -0.525}, "-3640,-1660": {-0.914, 0.405}, "-3640,-1560": {-0.914, 0.405}, "-3640,-1460": {-0.914, 0.405}, "-3640,-1360": {-0.914, 0.405}, "-3640,-1260": {-0.914, 0.405}, "-3640,-1160": {-0.699, 0.715}, "-3640,-1060": {-0.699, 0.715}, "-3640,-960": {-0.699, 0.715}, "-3640,-860": {-0.699, 0.715}, "-3640,-760": {-0.699, 0.715}, "-3640,-660": {-0.699, 0.715}, "-3640,-560": {-0.447, 0.894}, "-3640,-460": {-0.447, 0.894}, "-3640,-360": {-0.447, 0.894}, "-3640,-260": {-0.168, 0.986}, "-3640,-160": {-0.168, 0.986}, "-3640,-60": {-0.025, 1.000}, "-3640,40": {-0.025, 1.000}, "-3640,140": {-0.000, 1.000}, "-3640,240": {-0.000, 1.000}, "-3640,340": {-0.000, 1.000}, "-3640,440": {-0.000, 1.000}, "-3640,540": {-0.000, 1.000}, "-3640,640": {-0.000, -1.000}, "-3640,740": {-0.000, -1.000}, "-3640,840": {-0.000, -1.000}, "-3640,940": {-0.000, -1.000}, "-3640,1040": {-0.000, -1.000}, "-3640,1140": {-0.042, -0.999}, "-3640,1240": {-0.042, -0.999}, "-3640,1340": {-0.252, -0.968}, "-3640,1440": {-0.252, -0.968}, "-3640,1540": {-0.252, -0.968}, "-3640,1640": {-0.502, -0.865}, "-3640,1740": {-0.502, -0.865}, "-3640,1840": {-0.502, -0.865}, "-3640,1940": {-0.502, -0.865}, "-3640,2040": {-0.699, -0.715}, "-3640,2140": {-0.699, -0.715}, "-3640,2240": {-0.699, -0.715}, "-3640,2340": {-0.699, -0.715}, "-3640,2440": {-0.699, -0.715}, "-3640,2540": {-0.699, -0.715}, "-3640,2640": {-0.851, -0.525}, "-3640,2740": {-0.851, -0.525}, "-3640,2840": {-0.851, -0.525}, "-3640,2940": {-0.851, -0.525}, "-3640,3040": {-0.851, -0.525}, "-3640,3140": {-0.851, -0.525}, "-3640,3240": {-0.851, -0.525}, "-3540,-1760": {-0.914, 0.405}, "-3540,-1660": {-0.914, 0.405}, "-3540,-1560": {-0.914, 0.405}, "-3540,-1460": {-0.914, 0.405}, "-3540,-1360": {-0.914, 0.405}, "-3540,-1260": {-0.914, 0.405}, "-3540,-1160": {-0.826, 0.564}, "-3540,-1060": {-0.699, 0.715}, "-3540,-960": {-0.699, 0.715}, "-3540,-860": {-0.699, 0.715}, "-3540,-760": {-0.699, 0.715}, "-3540,-660": {-0.699, 0.715}, "-3540,-560": {-0.699, 0.715}, "-3540,-460": {-0.447, 0.894}, "-3540,-360": {-0.447, 0.894}, "-3540,-260": {-0.168, 0.986}, "-3540,-160": {-0.168, 0.986}, "-3540,-60": {-0.025, 1.000}, "-3540,40": {-0.025, 1.000}, "-3540,140": {-0.000, 1.000}, "-3540,240": {-0.000, 1.000}, "-3540,340": {-0.000, 1.000}, "-3540,440": {-0.000, 1.000}, "-3540,540": {-0.000, -1.000}, "-3540,640": {-0.000, -1.000}, "-3540,740": {-0.000, -1.000}, "-3540,840": {-0.000, -1.000}, "-3540,940": {-0.000, -1.000}, "-3540,1040": {-0.000, -1.000}, "-3540,1140": {-0.042, -0.999}, "-3540,1240": {-0.042, -0.999}, "-3540,1340": {-0.252, -0.968}, "-3540,1440": {-0.252, -0.968}, "-3540,1540": {-0.252, -0.968}, "-3540,1640": {-0.502, -0.865}, "-3540,1740": {-0.502, -0.865}, "-3540,1840": {-0.502, -0.865}, "-3540,1940": {-0.699, -0.715}, "-3540,2040": {-0.699, -0.715}, "-3540,2140": {-0.699, -0.715}, "-3540,2240": {-0.699, -0.715}, "-3540,2340": {-0.699, -0.715}, "-3540,2440": {-0.699, -0.715}, "-3540,2540": {-0.882, -0.471}, "-3540,2640": {-0.851, -0.525}, "-3540,2740": {-0.851, -0.525}, "-3540,2840": {-0.851, -0.525}, "-3540,2940": {-0.851, -0.525}, "-3540,3040": {-0.851, -0.525}, "-3540,3140": {-0.988, -0.156}, "-3540,3240": {-0.988, -0.156}, "-3440,-1760": {-0.971, 0.237}, "-3440,-1660": {-0.971, 0.237}, "-3440,-1560": {-0.971, 0.237}, "-3440,-1460": {-0.971, 0.237}, "-3440,-1360": {-0.914, 0.405}, "-3440,-1260": {-0.826, 0.564}, "-3440,-1160": {-0.826, 0.564}, "-3440,-1060": {-0.826, 0.564}, "-3440,-960": {-0.826, 0.564}, "-3440,-860": {-0.699, 0.715}, "-3440,-760": {-0.699, 0.715}, "-3440,-660": {-0.699, 0.715}, "-3440,-560": {-0.699, 0.715}, "-3440,-460": {-0.447, 0.894}, "-3440,-360": {-0.447, 0.894}, "-3440,-260": {-0.447, 0.894}, "-3440,-160": {-0.168, 0.986}, "-3440,-60": {-0.025, 1.000}, "-3440,40": {-0.025, 1.000}, "-3440,140": {-0.000, 1.000}, "-3440,240": {-0.000, 1.000}, "-3440,340": {-0.000, 1.000}, "-3440,440": {-0.000, 1.000}, "-3440,540": {-0.000, -1.000}, "-3440,640": {-0.000, -1.000}, "-3440,740": {-0.000, -1.000}, "-3440,840": {-0.000, -1.000}, "-3440,940": {-0.000, -1.000}, "-3440,1040": {-0.000, -1.000}, "-3440,1140": {-0.042, -0.999}, "-3440,1240": {-0.042, -0.999}, "-3440,1340": {-0.252, -0.968}, "-3440,1440": {-0.252, -0.968}, "-3440,1540": {-0.502, -0.865}, "-3440,1640": {-0.502, -0.865}, "-3440,1740": {-0.502, -0.865}, "-3440,1840": {-0.699, -0.715}, "-3440,1940": {-0.699, -0.715}, "-3440,2040": {-0.699, -0.715}, "-3440,2140": {-0.699, -0.715}, "-3440,2240": {-0.699, -0.715}, "-3440,2340": {-0.699, -0.715}, "-3440,2440": {-0.882, -0.471}, "-3440,2540": {-0.882, -0.471}, "-3440,2640": {-0.882, -0.471}, "-3440,2740": {-0.851, -0.525}, "-3440,2840": {-0.988, -0.156}, "-3440,2940": {-0.988, -0.156}, "-3440,3040": {-0.988, -0.156}, "-3440,3140": {-0.988, -0.156}, "-3440,3240": {-0.988, -0.156}, "-3340,-1760": {-0.971, 0.237}, "-3340,-1660": {-0.971, 0.237}, "-3340,-1560": {-0.971, 0.237}, "-3340,-1460": {-0.971, 0.237}, "-3340,-1360": {-0.826, 0.564}, "-3340,-1260": {-0.826, 0.564}, "-3340,-1160": {-0.826, 0.564}, "-3340,-1060": {-0.826, 0.564}, "-3340,-960": {-0.826, 0.564}, "-3340,-860": {-0.826, 0.564}, "-3340,-760": {-0.699, 0.715}, "-3340,-660": {-0.699, 0.715}, "-3340,-560": {-0.699, 0.715}, "-3340,-460": {-0.699, 0.715}, "-3340,-360": {-0.447, 0.894}, "-3340,-260": {-0.447, 0.894}, "-3340,-160": {-0.168, 0.986}, "-3340,-60": {-0.168, 0.986}, "-3340,40": {-0.025, 1.000}, "-3340,140": {-0.000, 1.000}, "-3340,240": {-0.000, 1.000}, "-3340,340": {-0.000, 1.000}, "-3340,440": {-0.000, 1.000}, "-3340,540": {-0.000, -1.000}, "-3340,640": {-0.000, -1.000}, "-3340,740": {-0.000, -1.000}, "-3340,840": {-0.000, -1.000}, "-3340,940": {-0.000, -1.000}, "-3340,1040": {-0.000, -1.000}, "-3340,1140": {-0.042, -0.999}, "-3340,1240": {-0.042, -0.999}, "-3340,1340": {-0.252, -0.968}, "-3340,1440": {-0.252, -0.968}, "-3340,1540": {-0.502, -0.865}, "-3340,1640": {-0.502, -0.865}, "-3340,1740": {-0.502, -0.865}, "-3340,1840": {-0.699, -0.715}, "-3340,1940": {-0.699, -0.715}, "-3340,2040": {-0.699, -0.715}, "-3340,2140": {-0.699, -0.715}, "-3340,2240": {-0.699, -0.715}, "-3340,2340": {-0.882, -0.471}, "-3340,2440": {-0.882, -0.471}, "-3340,2540": {-0.882, -0.471}, "-3340,2640": {-0.882, -0.471}, "-3340,2740": {-0.988, -0.156}, "-3340,2840": {-0.988, -0.156}, "-3340,2940": {-0.988, -0.156}, "-3340,3040": {-0.988, -0.156}, "-3340,3140": {-0.988, -0.156}, "-3340,3240": {-0.988, -0.156}, "-3340,3340": {-0.988, -0.156}, "-3240,-1760": {-0.971, 0.237}, "-3240,-1660": {-0.971, 0.237}, "-3240,-1560": {-0.971, 0.237}, "-3240,-1460": {-0.971, 0.237}, "-3240,-1360": {-0.826, 0.564}, "-3240,-1260": {-0.826, 0.564}, "-3240,-1160": {-0.826, 0.564}, "-3240,-1060": {-0.826, 0.564}, "-3240,-960": {-0.826, 0.564}, "-3240,-860": {-0.826, 0.564}, "-3240,-760": {-0.826, 0.564}, "-3240,-660": {-0.699, 0.715}, "-3240,-560": {-0.699, 0.715}, "-3240,-460": {-0.699, 0.715}, "-3240,-360": {-0.699, 0.715}, "-3240,-260": {-0.397, 0.918}, "-3240,-160": {-0.397, 0.918}, "-3240,-60": {-0.130, 0.991}, "-3240,40": {-0.130, 0.991}, "-3240,140": {-0.130, 0.991}, "-3240,240": {0.000, 1.000}, "-3240,340": {0.000, 1.000}, "-3240,440": {0.000, 1.000}, "-3240,540": {0.000, 1.000}, "-3240,640": {-0.000, -1.000}, "-3240,740": {-0.000, -1.000}, "-3240,840": {-0.000, -1.000}, "-3240,940": {-0.045, -0.999}, "-3240,1040": {-0.045, -0.999}, "-3240,1140": {-0.294, -0.956}, "-3240,1240": {-0.294, -0.956}, "-3240,1340": {-0.294, -0.956}, "-3240,1440": {-0.502, -0.865}, "-3240,1540": {-0.502, -0.865}, "-3240,1640": {-0.502, -0.865}, "-3240,1740": {-0.699, -0.715}, "-3240,1840": {-0.699, -0.715}, "-3240,1940": {-0.699, -0.715}, "-3240,2040": {-0.699, -0.715}, "-3240,2140": {-0.882, -0.471}, "-3240,2240": {-0.882, -0.471}, "-3240,2340": {-0.882, -0.471}, "-3240,2440": {-0.882, -0.471}, "-3240,2540": {-0.882, -0.471}, "-3240,2640": {-0.882, -0.471}, "-3240,2740": {-0.882, -0.471}, "-3240,2840": {-0.988, -0.156}, "-3240,2940": {-0.988, -0.156}, "-3240,3040": {-0.988, -0.156}, "-3240,3140": {-0.988, -0.156}, "-3240,3240": {-0.988, -0.156}, "-3240,3340": {-0.988, -0.156}, "-3140,-1860": {-0.971, 0.237}, "-3140,-1760": {-0.971, 0.237}, "-3140,-1660": {-0.971, 0.237}, "-3140,-1560": {-0.971, 0.237}, "-3140,-1460": {-0.971, 0.237}, "-3140,-1360": {-0.925, 0.379}, "-3140,-1260": {-0.925, 0.379}, "-3140,-1160": {-0.826, 0.564}, "-3140,-1060": {-0.826, 0.564}, "-3140,-960": {-0.826, 0.564}, "-3140,-860": {-0.826, 0.564}, "-3140,-760": {-0.826, 0.564}, "-3140,-660": {-0.826, 0.564}, "-3140,-560": {-0.699, 0.715}, "-3140,-460": {-0.699, 0.715}, "-3140,-360": {-0.632, 0.775}, "-3140,-260": {-0.397, 0.918}, "-3140,-160": {-0.397, 0.918}, "-3140,-60": {-0.130, 0.991}, "-3140,40": {-0.130, 0.991}, "-3140,140": {-0.130, 0.991}, "-3140,240": {0.000, 1.000}, "-3140,340": {0.000, 1.000}, "-3140,440": {0.000, 1.000}, "-3140,540": {0.000, 1.000}, "-3140,640": {-0.000, -1.000}, "-3140,740": {-0.000, -1.000}, "-3140,840": {-0.000, -1.000}, "-3140,940": {-0.045, -0.999}, "-3140,1040": {-0.045, -0.999}, "-3140,1140": {-0.294, -0.956}, "-3140,1240": {-0.294, -0.956}, "-3140,1340": {-0.294, -0.956}, "-3140,1440": {-0.555, -0.832}, "-3140,1540": {-0.555, -0.832}, "-3140,1640": {-0.699, -0.715}, "-3140,1740": {-0.699, -0.715}, "-3140,1840": {-0.699, -0.715}, "-3140,1940": {-0.699, -0.715}, "-3140,2040": {-0.882, -0.471}, "-3140,2140": {-0.882, -0.471}, "-3140,2240": {-0.882, -0.471}, "-3140,2340": {-0.882, -0.471}, "-3140,2440": {-0.882, -0.471}, "-3140,2540": {-0.882, -0.471}, "-3140,2640": {-0.882, -0.471}, "-3140,2740": {-0.882, -0.471}, "-3140,2840": {-0.988, -0.156}, "-3140,2940": {-0.988, -0.156}, "-3140,3040": {-0.988, -0.156}, "-3140,3140": {-0.988, -0.156}, "-3140,3240": {-0.988, -0.156}, "-3140,3340": {-0.988, -0.156}, "-3040,-1860": {-0.992, 0.127}, "-3040,-1760": {-0.992, 0.127}, "-3040,-1660": {-0.992, 0.127}, "-3040,-1560": {-0.992, 0.127}, "-3040,-1460": {-0.925, 0.379}, "-3040,-1360": {-0.925, 0.379}, "-3040,-1260": {-0.925, 0.379}, "-3040,-1160": {-0.925, 0.379}, "-3040,-1060": {-0.826, 0.564}, "-3040,-960": {-0.826, 0.564}, "-3040,-860": {-0.826, 0.564}, "-3040,-760": {-0.826, 0.564}, "-3040,-660": {-0.826, 0.564}, "-3040,-560": {-0.826, 0.564}, "-3040,-460": {-0.632, 0.775}, "-3040,-360": {-0.632, 0.775}, "-3040,-260": {-0.397, 0.918}, "-3040,-160": {-0.397, 0.918}, "-3040,-60": {-0.397, 0.918}, "-3040,40": {-0.130, 0.991}, "-3040,140": {-0.130, 0.991}, "-3040,240": {0.000, 1.000}, "-3040,340": {0.000, 1.000}, "-3040,440": {0.000, 1.000}, "-3040,540": {0.000, 1.000}, "-3040,640": {-0.000, -1.000}, "-3040,740": {-0.000, -1.000}, "-3040,840": {-0.000, -1.000}, "-3040,940": {-0.045, -0.999}, "-3040,1040": {-0.045, -0.999}, "-3040,1140": {-0.294, -0.956}, "-3040,1240": {-0.294, -0.956}, "-3040,1340": {-0.294, -0.956}, "-3040,1440": {-0.555, -0.832}, "-3040,1540": {-0.555, -0.832}, "-3040,1640": {-0.555, -0.832}, "-3040,1740": {-0.699, -0.715}, "-3040,1840": {-0.699, -0.715}, "-3040,1940": {-0.882, -0.471}, "-3040,2040": {-0.882, -0.471}, "-3040,2140": {-0.882, -0.471}, "-3040,2240": {-0.882, -0.471}, "-3040,2340": {-0.882, -0.471}, "-3040,2440": {-0.882, -0.471}, "-3040,2540": {-0.882, -0.471}, "-3040,2640": {-0.983, -0.183}, "-3040,2740": {-0.983, -0.183}, "-3040,2840": {-0.988, -0.156}, "-3040,2940": {-0.988, -0.156}, "-3040,3040": {-0.988, -0.156}, "-3040,3140": {-0.988, -0.156}, "-3040,3240": {-1.000, -0.027}, "-3040,3340": {-1.000, -0.027}, "-2940,-1860": {-0.992, 0.127}, "-2940,-1760": {-0.992, 0.127}, "-2940,-1660": {-0.992, 0.127}, "-2940,-1560": {-0.992, 0.127}, "-2940,-1460": {-0.925, 0.379}, "-2940,-1360": {-0.925, 0.379}, "-2940,-1260": {-0.925, 0.379}, "-2940,-1160": {-0.925, 0.379}, "-2940,-1060": {-0.925, 0.379}, "-2940,-960": {-0.925, 0.379}, "-2940,-860": {-0.826, 0.564}, "-2940,-760": {-0.826, 0.564}, "-2940,-660": {-0.826, 0.564}, "-2940,-560": {-0.632, 0.775}, "-2940,-460": {-0.632, 0.775}, "-2940,-360": {-0.632, 0.775}, "-2940,-260": {-0.632, 0.775}, "-2940,-160": {-0.397, 0.918}, "-2940,-60": {-0.397, 0.918}, "-2940,40": {-0.130, 0.991}, "-2940,140": {-0.130, 0.991}, "-2940,240": {0.000, 1.000}, "-2940,340": {0.000, 1.000}, "-2940,440": {0.000, 1.000}, "-2940,540": {0.000, 1.000}, "-2940,640": {-0.000, -1.000}, "-2940,740": {-0.000, -1.000}, "-2940,840": {-0.000, -1.000}, "-2940,940": {-0.045, -0.999}, "-2940,1040": {-0.045, -0.999}, "-2940,1140": {-0.294, -0.956}, "-2940,1240": {-0.294, -0.956}, "-2940,1340": {-0.555, -0.832}, "-2940,1440": {-0.555, -0.832}, "-2940,1540": {-0.555, -0.832}, "-2940,1640": {-0.779, -0.627}, "-2940,1740": {-0.779, -0.627}, "-2940,1840": {-0.882, -0.471}, "-2940,1940": {-0.882, -0.471}, "-2940,2040": {-0.882, -0.471}, "-2940,2140": {-0.882, -0.471}, "-2940,2240": {-0.882, -0.471}, "-2940,2340": {-0.983, -0.183}, "-2940,2440": {-0.983, -0.183}, "-2940,2540": {-0.983, -0.183}, "-2940,2640": {-0.983, -0.183}, "-2940,2740": {-0.983, -0.183}, "-2940,2840": {-0.983, -0.183}, "-2940,2940": {-1.000, -0.027}, "-2940,3040": {-1.000, -0.027}, "-2940,3140": {-1.000, -0.027}, "-2940,3240": {-1.000, -0.027}, "-2940,3340": {-1.000, -0.027}, "-2840,-1860": {-0.992, 0.127}, "-2840,-1760": {-0.992, 0.127}, "-2840,-1660": {-0.992, 0.127}, "-2840,-1560": {-0.992, 0.127}, "-2840,-1460": {-0.925, 0.379}, "-2840,-1360": {-0.925, 0.379}, "-2840,-1260": {-0.925, 0.379}, "-2840,-1160": {-0.925, 0.379}, "-2840,-1060": {-0.925, 0.379}, "-2840,-960": {-0.925, 0.379}, "-2840,-860": {-0.925, 0.379}, "-2840,-760": {-0.925, 0.379}, "-2840,-660": {-0.794, 0.608}, "-2840,-560": {-0.794, 0.608}, "-2840,-460": {-0.632, 0.775}, "-2840,-360": {-0.632, 0.775}, "-2840,-260": {-0.632, 0.775}, "-2840,-160": {-0.397, 0.918}, "-2840,-60": {-0.397, 0.918}, "-2840,40": {-0.130, 0.991}, "-2840,140": {-0.113, 0.994}, "-2840,240": {0.000, 1.000}, "-2840,340": {0.000, 1.000}, "-2840,440": {0.000, 1.000}, "-2840,540": {0.000, 1.000}, "-2840,640": {-0.000, -1.000}, "-2840,740": {-0.000, -1.000}, "-2840,840": {-0.000, -1.000}, "-2840,940": {-0.045, -0.999}, "-2840,1040": {-0.045, -0.999}, "-2840,1140": {-0.294, -0.956}, "-2840,1240": {-0.294, -0.956}, "-2840,1340": {-0.555, -0.832}, "-2840,1440": {-0.555, -0.832}, "-2840,1540": {-0.779, -0.627}, "-2840,1640": {-0.779, -0.627}, "-2840,1740": {-0.779, -0.627}, "-2840,1840": {-0.779, -0.627}, "-2840,1940": {-0.882, -0.471}, "-2840,2040": {-0.983, -0.183}, "-2840,2140": {-0.983, -0.183}, "-2840,2240": {-0.983, -0.183}, "-2840,2340": {-0.983, -0.183}, "-2840,2440": {-0.983, -0.183}, "-2840,2540": {-0.983, -0.183}, "-2840,2640": {-0.983, -0.183}, "-2840,2740": {-0.983, -0.183}, "-2840,2840": {-1.000, -0.027}, "-2840,2940": {-1.000, -0.027}, "-2840,3040": {-1.000, -0.027}, "-2840,3140": {-1.000, -0.027}, "-2840,3240": {-1.000, -0.027}, "-2840,3340": {-1.000, -0.027}, "-2740,-1860": {-0.992, 0.127}, "-2740,-1760": {-0.992, 0.127}, "-2740,-1660": {-0.992, 0.127}, "-2740,-1560": {-0.992, 0.127}, "-2740,-1460": {-0.991, 0.132}, "-2740,-1360": {-0.991, 0.132}, "-2740,-1260": {-0.925, 0.379}, "-2740,-1160": {-0.925, 0.379}, "-2740,-1060": {-0.925, 0.379}, "-2740,-960": {-0.925, 0.379}, "-2740,-860": {-0.925, 0.379}, "-2740,-760": {-0.794, 0.608}, "-2740,-660": {-0.794, 0.608}, "-2740,-560": {-0.794, 0.608}, "-2740,-460": {-0.794, 0.608}, "-2740,-360": {-0.632, 0.775}, "-2740,-260": {-0.632, 0.775}, "-2740,-160": {-0.408, 0.913}, "-2740,-60": {-0.408, 0.913}, "-2740,40": {-0.113, 0.994}, "-2740,140": {-0.113, 0.994}, "-2740,240": {-0.113, 0.994}, "-2740,340": {0.000, 1.000}, "-2740,440": {0.000, 1.000}, "-2740,540": {0.000, 1.000}, "-2740,640": {0.000, 1.000}, "-2740,740": {0.000, 1.000}, "-2740,840": {-0.000, -1.000}, "-2740,940": {-0.045, -0.999}, "-2740,1040": {-0.045, -0.999}, "-2740,1140": {-0.294, -0.956}, "-2740,1240": {-0.555, -0.832}, "-2740,1340": {-0.555, -0.832}, "-2740,1440": {-0.779, -0.627}, "-2740,1540": {-0.779, -0.627}, "-2740,1640": {-0.779, -0.627}, "-2740,1740": {-0.779, -0.627}, "-2740,1840": {-0.779, -0.627}, "-2740,1940": {-0.983, -0.183}, "-2740,2040": {-0.983, -0.183}, "-2740,2140": {-0.983, -0.183}, "-2740,2240": {-0.983, -0.183}, "-2740,2340": {-0.983, -0.183}, "-2740,2440": {-0.983, -0.183}, "-2740,2540": {-0.983, -0.183}, "-2740,2640": {-0.983, -0.183}, "-2740,2740": {-0.983, -0.183}, "-2740,2840": {-1.000, -0.027}, "-2740,2940": {-1.000, -0.027}, "-2740,3040": {-1.000, -0.027}, "-2740,3140": {-1.000, -0.027}, "-2740,3240": {-1.000, -0.027}, "-2740,3340": {-1.000, -0.027}, "-2640,-1860": {-0.999, 0.038}, "-2640,-1760": {-0.999, 0.038}, "-2640,-1660": {-0.999, 0.038}, "-2640,-1560": {-0.999, 0.038}, "-2640,-1460": {-0.991, 0.132}, "-2640,-1360": {-0.991, 0.132}, "-2640,-1260": {-0.991, 0.132}, "-2640,-1160": {-0.991, 0.132}, "-2640,-1060": {-0.991, 0.132}, "-2640,-960": {-0.925, 0.379}, "-2640,-860": {-0.925, 0.379}, "-2640,-760": {-0.794, 0.608}, "-2640,-660": {-0.794, 0.608}, "-2640,-560": {-0.794, 0.608}, "-2640,-460": {-0.794, 0.608}, "-2640,-360": {-0.794, 0.608}, "-2640,-260": {-0.684, 0.730}, "-2640,-160": {-0.408, 0.913}, "-2640,-60": {-0.408, 0.913}, "-2640,40": {-0.408, 0.913}, "-2640,140": {-0.113, 0.994}, "-2640,240": {-0.113, 0.994}, "-2640,340": {0.000, 1.000}, "-2640,440": {0.000, 1.000}, "-2640,540": {0.000, 1.000}, "-2640,640": {0.000, 1.000}, "-2640,740": {0.000, 1.000}, "-2640,840": {-0.187, -0.982}, "-2640,940": {-0.187, -0.982}, "-2640,1040": {-0.187, -0.982}, "-2640,1140": {-0.294, -0.956}, "-2640,1240": {-0.555, -0.832}, "-2640,1340": {-0.555, -0.832}, "-2640,1440": {-0.779, -0.627}, "-2640,1540": {-0.779, -0.627}, "-2640,1640": {-0.779, -0.627}, "-2640,1740": {-0.936, -0.353}, "-2640,1840": {-0.936, -0.353}, "-2640,1940": {-0.936, -0.353}, "-2640,2040": {-0.983, -0.183}, "-2640,2140": {-0.983, -0.183}, "-2640,2240": {-0.983, -0.183}, "-2640,2340": {-0.983, -0.183}, "-2640,2440": {-0.983, -0.183}, "-2640,2540": {-0.983, -0.183}, "-2640,2640": {-0.983, -0.183}, "-2640,2740": {-0.983, -0.183}, "-2640,2840": {-1.000, -0.027}, "-2640,2940": {-1.000, -0.027}, "-2640,3040": {-1.000, -0.027}, "-2640,3140": {-1.000, -0.027}, "-2640,3240": {-1.000, -0.027}, "-2640,3340": {-1.000, -0.027}, "-2540,-1860": {-0.999, 0.038}, "-2540,-1760": {-0.999, 0.038}, "-2540,-1660": {-0.999, 0.038}, "-2540,-1560": {-0.999, 0.038}, "-2540,-1460": {-0.991, 0.132}, "-2540,-1360": {-0.991, 0.132}, "-2540,-1260": {-0.991, 0.132}, "-2540,-1160": {-0.991, 0.132}, "-2540,-1060": {-0.991, 0.132}, "-2540,-960": {-0.991, 0.132}, "-2540,-860": {-0.991, 0.132}, "-2540,-760": {-0.931, 0.366}, "-2540,-660": {-0.931, 0.366}, "-2540,-560": {-0.794, 0.608}, "-2540,-460": {-0.794, 0.608}, "-2540,-360": {-0.684, 0.730}, "-2540,-260": {-0.684, 0.730}, "-2540,-160": {-0.684, 0.730}, "-2540,-60": {-0.408, 0.913}, "-2540,40": {-0.408, 0.913}, "-2540,140": {-0.113, 0.994}, "-2540,240": {-0.113, 0.994}, "-2540,340": {0.000, 1.000}, "-2540,440": {0.000, 1.000}, "-2540,540": {0.000, 1.000}, "-2540,640": {-0.075, 0.997}, "-2540,740": {-0.187, -0.982}, "-2540,840": {-0.187, -0.982}, "-2540,940": {-0.187, -0.982}, "-2540,1040": {-0.575, -0.818}, "-2540,1140": {-0.575, -0.818}, "-2540,1240": {-0.575, -0.818}, "-2540,1340": {-0.779, -0.627}, "-2540,1440": {-0.779, -0.627}, "-2540,1540": {-0.779, -0.627}, "-2540,1640": {-0.936, -0.353}, "-2540,1740": {-0.936, -0.353}, "-2540,1840": {-0.936, -0.353}, "-2540,1940": {-0.936, -0.353}, "-2540,2040": {-0.983, -0.183}, "-2540,2140": {-0.983, -0.183}, "-2540,2240": {-0.983, -0.183}, "-2540,2340": {-1.000, -0.031}, "-2540,2440": {-1.000, -0.031}, "-2540,2540": {-1.000, -0.031}, "-2540,2640": {-1.000, -0.031}, "-2540,2740": {-1.000, -0.031}, "-2540,2840": {-1.000, -0.031}, "-2540,2940": {-1.000, -0.027}, "-2540,3040": {-1.000, -0.027}, "-2540,3140": {-1.000, -0.027}, "-2540,3240": {-1.000, -0.027}, "-2540,3340": {-1.000, -0.027}, "-2440,-1860": {-0.999, 0.038}, "-2440,-1760": {-0.999, 0.038}, "-2440,-1660": {-0.999, 0.038}, "-2440,-1560": {-0.991, 0.132}, "-2440,-1460": {-0.991, 0.132}, "-2440,-1360": {-0.991, 0.132}, "-2440,-1260": {-0.991, 0.132}, "-2440,-1160": {-0.991, 0.132}, "-2440,-1060": {-0.991, 0.132}, "-2440,-960": {-0.991, 0.132}, "-2440,-860": {-0.931, 0.366}, "-2440,-760": {-0.931, 0.366}, "-2440,-660": {-0.931, 0.366}, "-2440,-560": {-0.931, 0.366}, "-2440,-460": {-0.890, 0.457}, "-2440,-360": {-0.890, 0.457}, "-2440,-260": {-0.684, 0.730}, "-2440,-160": {-0.684, 0.730}, "-2440,-60": {-0.408, 0.913}, "-2440,40": {-0.300, 0.954}, "-2440,140": {-0.300, 0.954}, "-2440,240": {-0.300, 0.954}, "-2440,340": {-0.300, 0.954}, "-2440,440": {-0.075, 0.997}, "-2440,540": {-0.075, 0.997}, "-2440,640": {-0.075, 0.997}, "-2440,740": {-0.187, -0.982}, "-2440,840": {-0.187, -0.982}, "-2440,940": {-0.187, -0.982}, "-2440,1040": {-0.575, -0.818}, "-2440,1140": {-0.575, -0.818}, "-2440,1240": {-0.575, -0.818}, "-2440,1340": {-0.944, -0.330}, "-2440,1440": {-0.936, -0.353}, "-2440,1540": {-0.936, -0.353}, "-2440,1640": {-0.936, -0.353}, "-2440,1740": {-0.936, -0.353}, "-2440,1840": {-0.936, -0.353}, "-2440,1940": {-0.936, -0.353}, "-2440,2040": {-1.000, -0.031}, "-2440,2140": {-1.000, -0.031}, "-2440,2240": {-1.000, -0.031}, "-2440,2340": {-1.000, -0.031}, "-2440,2440": {-1.000, -0.031}, "-2440,2540": {-1.000, -0.031}, "-2440,2640": {-1.000, -0.031}, "-2440,2740": {-1.000, -0.031}, "-2440,2840": {-1.000, -0.031}, "-2440,2940": {-1.000, -0.027}, "-2440,3040": {-1.000, -0.027}, "-2440,3140": {-1.000, -0.027}, "-2440,3240": {-1.000, -0.027}, "-2440,3340": {-1.000, -0.027}, "-2340,-1860": {-0.999, 0.038}, "-2340,-1760": {-0.999, 0.038}, "-2340,-1660": {-0.999, 0.038}, "-2340,-1560": {-0.991, 0.132}, "-2340,-1460": {-0.991, 0.132}, "-2340,-1360": {-0.991, 0.132}, "-2340,-1260": {-0.991, 0.132}, "-2340,-1160": {-0.991, 0.132}, "-2340,-1060": {-0.991, 0.132}, "-2340,-960": {-0.991, 0.132}, "-2340,-860": {-0.931, 0.366}, "-2340,-760": {-0.931, 0.366}, "-2340,-660": {-0.931, 0.366}, "-2340,-560": {-0.931, 0.366}, "-2340,-460": {-0.890, 0.457}, "-2340,-360": {-0.890, 0.457}, "-2340,-260": {-0.890, 0.457}, "-2340,-160": {-0.839, 0.544}, "-2340,-60": {-0.839, 0.544}, "-2340,1240": {-0.944, -0.330}, "-2340,1340": {-0.944, -0.330}, "-2340,1440": {-0.936, -0.353}, "-2340,1540": {-0.936, -0.353}, "-2340,1640": {-0.936, -0.353}, "-2340,1740": {-0.936, -0.353}, "-2340,1840": {-0.996, -0.087}, "-2340,1940": {-0.996, -0.087}, "-2340,2040": {-1.000, -0.031}, "-2340,2140": {-1.000, -0.031}, "-2340,2240": {-1.000, -0.031}, "-2340,2340": {-1.000, -0.031}, "-2340,2440": {-1.000, -0.031}, "-2340,2540": {-1.000, -0.031}, "-2340,2640": {-1.000, -0.031}, "-2340,2740": {-1.000, -0.031}, "-2340,2840": {-1.000, -0.031}, "-2340,2940": {-1.000, -0.027}, "-2340,3040": {-1.000, -0.027}, "-2340,3140": {-1.000, -0.027}, "-2340,3240": {-1.000, -0.027}, "-2340,3340": {-1.000, -0.027}, "-2240,-1860": {-0.999, 0.038}, "-2240,-1760": {-0.999, 0.038}, "-2240,-1660": {-0.999, 0.038}, "-2240,-1560": {-1.000, 0.020}, "-2240,-1460": {-1.000, 0.020}, "-2240,-1360": {-1.000, 0.020}, "-2240,-1260": {-1.000, 0.020}, "-2240,-1160": {-1.000, 0.020}, "-2240,-1060": {-1.000, 0.020}, "-2240,-960": {-1.000, 0.020}, "-2240,-860": {-0.993, 0.120}, "-2240,-760": {-0.931, 0.366}, "-2240,-660": {-0.931, 0.366}, "-2240,-560": {-0.931, 0.366}, "-2240,-460": {-0.994, 0.106}, "-2240,-360": {-0.890, 0.457}, "-2240,-260": {-0.890, 0.457}, "-2240,-160": {-0.839, 0.544}, "-2240,1240": {-0.944, -0.330}, "-2240,1340": {-0.944, -0.330}, "-2240,1440": {-0.944, -0.330}, "-2240,1540": {-0.996, -0.087}, "-2240,1640": {-0.996, -0.087}, "-2240,1740": {-0.996, -0.087}, "-2240,1840": {-0.996, -0.087}, "-2240,1940": {-0.996, -0.087}, "-2240,2040": {-1.000, -0.031}, "-2240,2140": {-1.000, -0.031}, "-2240,2240": {-1.000, -0.031}, "-2240,2340": {-1.000, -0.031}, "-2240,2440": {-1.000, -0.031}, "-2240,2540": {-1.000, -0.031}, "-2240,2640": {-1.000, -0.031}, "-2240,2740": {-1.000, -0.031}, "-2240,2840": {-1.000, -0.031}, "-2240,2940": {-1.000, 0.000}, "-2240,3040": {-1.000, 0.000}, "-2240,3140": {-1.000, 0.000}, "-2240,3240": {-1.000, 0.000}, "-2240,3340": {-1.000, 0.000}, "-2140,-1860": {-1.000, 0.005}, "-2140,-1760": {-1.000, 0.005}, "-2140,-1660": {-1.000, 0.005}, "-2140,-1560": {-1.000, 0.020}, "-2140,-1460": {-1.000, 0.020}, "-2140,-1360": {-1.000, 0.020}, "-2140,-1260": {-1.000, 0.020}, "-2140,-1160": {-1.000, 0.020}, "-2140,-1060": {-1.000, 0.020}, "-2140,-960": {-1.000, 0.020}, "-2140,-860": {-0.993, 0.120}, "-2140,-760": {-0.993, 0.120}, "-2140,-660": {-0.993, 0.120}, "-2140,-560": {-0.994, 0.106}, "-2140,-460": {-0.994, 0.106}, "-2140,-360": {-0.994, 0.106}, "-2140,-260": {-0.839, 0.544}, "-2140,-160": {-0.839, 0.544}, "-2140,1240": {-0.944, -0.330}, "-2140,1340": {-0.944, -0.330}, "-2140,1440": {-0.944, -0.330}, "-2140,1540": {-0.996, -0.087}, "-2140,1640": {-0.996, -0.087}, "-2140,1740": {-0.996, -0.087}, "-2140,1840": {-0.996, -0.087}, "-2140,1940": {-0.996, -0.087}, "-2140,2040": {-0.996, -0.087}, "-2140,2140": {-1.000, -0.031}, "-2140,2240": {-1.000, -0.031}, "-2140,2340": {-1.000, -0.031}, "-2140,2440": {-1.000, -0.031}, "-2140,2540": {-1.000, -0.031}, "-2140,2640": {-1.000, -0.031}, "-2140,2740": {-1.000, -0.031}, "-2140,2840": {-1.000, -0.031}, "-2140,2940": {-1.000, 0.000}, "-2140,3040": {-1.000, 0.000}, "-2140,3140": {-1.000, 0.000}, "-2140,3240": {-1.000, 0.000}, "-2140,3340": {-1.000, 0.000}, "-2040,-1860": {-1.000, 0.005}, "-2040,-1760": {-1.000, 0.005}, "-2040,-1660": {-1.000, 0.005}, "-2040,-1560": {-1.000, 0.020}, "-2040,-1460": {-1.000, 0.020}, "-2040,-1360": {-1.000, 0.020}, "-2040,-1260": {-1.000, 0.020}, "-2040,-1160": {-1.000, 0.020}, "-2040,-1060": {-1.000, 0.020}, "-2040,-960": {-1.000, 0.020}, "-2040,-860": {-0.993, 0.120}, "-2040,-760": {-0.993, 0.120}, "-2040,-660": {-0.993, 0.120}, "-2040,-560": {-0.994, 0.106}, "-2040,-460": {-0.994, 0.106}, "-2040,-360": {-0.994, 0.106}, "-2040,-260": {-0.994, 0.106}, "-2040,1240": {-0.999, -0.053}, "-2040,1340": {-0.999, -0.053}, "-2040,1440": {-0.999, -0.053}, "-2040,1540": {-1.000, 0.000}, "-2040,1640": {-1.000, 0.000}, "-2040,1740": {-1.000, 0.000}, "-2040,1840": {-1.000, 0.000}, "-2040,1940": {-1.000, 0.000}, "-2040,2040": {-1.000, 0.000}, "-2040,2140": {-1.000, 0.000}, "-2040,2240": {-1.000, 0.000}, "-2040,2340": {-1.000, 0.000}, "-2040,2440": {-1.000, 0.000}, "-2040,2540": {-1.000, 0.000}, "-2040,2640": {-1.000, 0.000}, "-2040,2740": {-1.000, 0.000}, "-2040,2840": {-1.000, 0.000}, "-2040,2940": {-1.000, 0.000}, "-2040,3040": {-1.000, 0.000}, "-2040,3140": {-1.000, 0.000}, "-2040,3240": {-1.000, 0.000}, "-2040,3340": {-1.000, 0.000}, "-1940,-1860": {-1.000, 0.005}, "-1940,-1760": {-1.000, 0.005}, "-1940,-1660": {-1.000, 0.005}, "-1940,-1560": {-1.000, 0.020}, "-1940,-1460": {-1.000, 0.020}, "-1940,-1360": {-1.000, 0.020}, "-1940,-1260": {-1.000, 0.020}, "-1940,-1160": {-1.000, 0.020}, "-1940,-1060": {-1.000, 0.020}, "-1940,-960": {-1.000, 0.020}, "-1940,-860": {-0.993, 0.120}, "-1940,-760": {-0.993, 0.120}, "-1940,-660": {-0.993, 0.120}, "-1940,-560": {-1.000, 0.000}, "-1940,-460": {-1.000, 0.000}, "-1940,-360": {-1.000, 0.000}, "-1940,-260": {-0.995, 0.097}, "-1940,1240": {-0.999, -0.053}, "-1940,1340": {-0.999, -0.053}, "-1940,1440": {-0.999, -0.053}, "-1940,1540": {-1.000, 0.000}, "-1940,1640": {-1.000, 0.000}, "-1940,1740": {-1.000, 0.000}, "-1940,1840": {-1.000, 0.000}, "-1940,1940": {-1.000, 0.000}, "-1940,2040": {-1.000, 0.000}, "-1940,2140": {-1.000, 0.000}, "-1940,2240": {-1.000, 0.000}, "-1940,2340": {-1.000, 0.000}, "-1940,2440": {-1.000, 0.000}, "-1940,2540": {-1.000, 0.000}, "-1940,2640": {-1.000, 0.000}, "-1940,2740": {-1.000, 0.000}, "-1940,2840": {-1.000, 0.000}, "-1940,2940": {-1.000, 0.000}, "-1940,3040": {-1.000, 0.000}, "-1940,3140": {-1.000, 0.000}, "-1940,3240": {-1.000, 0.000}, "-1940,3340": {-1.000, 0.000}, "-1840,-1860": {-1.000, 0.005}, "-1840,-1760": {-1.000, 0.005}, "-1840,-1660": {-1.000, 0.005}, "-1840,-1560": {-1.000, 0.005}, "-1840,-1460": {-1.000, 0.020}, "-1840,-1360": {-1.000, 0.020}, "-1840,-1260": {-1.000, 0.020}, "-1840,-1160": {-1.000, 0.020}, "-1840,-1060": {-1.000, 0.020}, "-1840,-960": {-1.000, 0.014}, "-1840,-860": {-1.000, 0.014}, "-1840,-760": {-1.000, 0.014}, "-1840,-660": {-1.000, 0.014}, "-1840,-560": {-1.000, 0.000}, "-1840,-460": {-1.000, 0.000}, "-1840,-360": {-1.000, 0.000}, "-1840,-260": {-0.995, 0.097}, "-1840,1240": {-0.999, -0.053}, "-1840,1340": {-0.999, -0.053}, "-1840,1440": {-0.999, -0.053}, "-1840,1540": {-1.000, 0.000}, "-1840,1640": {-1.000, 0.000}, "-1840,1740": {-1.000, 0.000}, "-1840,1840": {-1.000, 0.000}, "-1840,1940": {-1.000, 0.000}, "-1840,2040": {-1.000, 0.000}, "-1840,2140": {-1.000, 0.000}, "-1840,2240": {-1.000, 0.000}, "-1840,2340": {-1.000, 0.000}, "-1840,2440": {-1.000, 0.000}, "-1840,2540": {-1.000, 0.000}, "-1840,2640": {-1.000, 0.000}, "-1840,2740": {-1.000, 0.000}, "-1840,2840": {-1.000, 0.000}, "-1840,2940": {-1.000, 0.000}, "-1840,3040": {-1.000, 0.000}, "-1840,3140": {-1.000, 0.000}, "-1840,3240": {-1.000, 0.000}, "-1840,3340": {-1.000, 0.000}, "-1740,-1860": {-1.000, 0.005}, "-1740,-1760": {-1.000, 0.005}, "-1740,-1660": {-1.000, 0.005}, "-1740,-1560": {-1.000, 0.005}, "-1740,-1460": {-1.000, -0.000}, "-1740,-1360": {-1.000, -0.000}, "-1740,-1260": {-1.000, -0.000}, "-1740,-1160": {-1.000, -0.000}, "-1740,-1060": {-1.000, -0.000}, "-1740,-960": {-1.000, 0.014}, "-1740,-860": {-1.000, 0.014}, "-1740,-760": {-1.000, 0.014}, "-1740,-660": {-1.000, 0.014}, "-1740,-560": {-1.000, 0.000}, "-1740,-460": {-1.000, 0.000}, "-1740,-360": {-1.000, 0.000}, "-1740,-260": {-0.995, 0.097}, "-1740,1240": {-0.999, -0.053}, "-1740,1340": {-0.999, -0.053}, "-1740,1440": {-0.999, -0.053}, "-1740,1540": {-1.000, 0.000}, "-1740,1640": {-1.000, 0.000}, "-1740,1740": {-1.000, 0.000}, "-1740,1840": {-1.000, 0.000}, "-1740,1940": {-1.000, 0.000}, "-1740,2040": {-1.000, 0.000}, "-1740,2140": {-1.000, 0.000}, "-1740,2240": {-1.000, 0.000}, "-1740,2340": {-1.000, 0.000}, "-1740,2440": {-1.000, 0.000}, "-1740,2540": {-1.000, 0.000}, "-1740,2640": {-1.000, 0.000}, "-1740,2740": {-1.000, 0.000}, "-1740,2840": {-1.000, 0.000}, "-1740,2940": {-1.000, 0.000}, "-1740,3040": {-1.000, 0.000}, "-1740,3140": {-1.000, 0.000}, "-1740,3240": {-1.000, 0.000}, "-1740,3340": {-1.000, 0.000}, "-1640,-1860": {-1.000, 0.005}, "-1640,-1760": {-1.000, 0.005}, "-1640,-1660": {-1.000, 0.005}, "-1640,-1560": {-1.000, -0.000}, "-1640,-1460": {-1.000, -0.000}, "-1640,-1360": {-1.000, -0.000}, "-1640,-1260": {-1.000, -0.000}, "-1640,-1160": {-1.000, -0.000}, "-1640,-1060": {-1.000, -0.000}, "-1640,-960": {-1.000, -0.000}, "-1640,-860": {-1.000, 0.014}, "-1640,-760": {-1.000, 0.014}, "-1640,-660": {-1.000, 0.014}, "-1640,-560": {-1.000, 0.000}, "-1640,-460": {-1.000, 0.000}, "-1640,-360": {-1.000, 0.000}, "-1640,-260": {-1.000, 0.000}, "-1640,1240": {-1.000, 0.010}, "-1640,1340": {-1.000, 0.010}, "-1640,1440": {-1.000, 0.010}, "-1640,1540": {-1.000, 0.000}, "-1640,1640": {-1.000, 0.000}, "-1640,1740": {-1.000, 0.000}, "-1640,1840": {-1.000, 0.000}, "-1640,1940": {-1.000, 0.000}, "-1640,2040": {-1.000, 0.000}, "-1640,2140": {-1.000, 0.000}, "-1640,2240": {-1.000, 0.000}, "-1640,2340": {-1.000, 0.000}, "-1640,2440": {-1.000, 0.000}, "-1640,2540": {-1.000, 0.000}, "-1640,2640": {-1.000, 0.000}, "-1640,2740": {-1.000, 0.000}, "-1640,2840": {-1.000, 0.000}, "-1640,2940": {-1.000, 0.000}, "-1640,3040": {-1.000, 0.000}, "-1640,3140": {-1.000, 0.000}, "-1640,3240": {-1.000, 0.000}, "-1640,3340": {-1.000, 0.000}, "-1540,-1860": {-1.000, 0.005}, "-1540,-1760": {-1.000, 0.005}, "-1540,-1660": {-1.000, 0.005}, "-1540,-1560": {-1.000, -0.000}, "-1540,-1460": {-1.000, -0.000}, "-1540,-1360": {-1.000, -0.000}, "-1540,-1260": {-1.000, -0.000}, "-1540,-1160": {-1.000, -0.000}, "-1540,-1060": {-1.000, -0.000}, "-1540,-960": {-1.000, -0.000}, "-1540,-860": {-1.000, 0.000}, "-1540,-760": {-1.000, 0.000}, "-1540,-660": {-1.000, 0.000}, "-1540,-560": {-1.000, 0.000}, "-1540,-460": {-1.000, 0.000}, "-1540,-360": {-1.000, 0.000}, "-1540,-260": {-1.000, 0.000}, "-1540,1240": {-1.000, 0.010}, "-1540,1340": {-1.000, 0.010}, "-1540,1440": {-1.000, 0.010}, "-1540,1540": {-1.000, 0.000}, "-1540,1640": {-1.000, 0.000}, "-1540,1740": {-1.000, 0.000}, "-1540,1840": {-1.000, 0.000}, "-1540,1940": {-1.000, 0.000}, "-1540,2040": {-1.000, 0.000}, "-1540,2140": {-1.000, 0.000}, "-1540,2240": {-1.000, 0.000}, "-1540,2340": {-1.000, 0.000}, "-1540,2440": {-1.000, 0.000}, "-1540,2540": {-1.000, 0.000}, "-1540,2640": {-1.000, 0.000}, "-1540,2740": {-1.000, 0.000}, "-1540,2840": {-1.000, 0.000}, "-1540,2940": {-1.000, 0.000}, "-1540,3040": {-1.000, 0.000}, "-1540,3140": {-1.000, 0.000}, "-1540,3240": {-1.000, 0.000}, "-1540,3340": {-1.000, 0.000}, "-1440,-1860": {-1.000, 0.005}, "-1440,-1760": {-1.000, 0.005}, "-1440,-1660": {-1.000, -0.000}, "-1440,-1560": {-1.000, -0.000}, "-1440,-1460": {-1.000, -0.000}, "-1440,-1360": {-1.000, -0.000}, "-1440,-1260": {-1.000, -0.000}, "-1440,-1160": {-1.000, -0.000}, "-1440,-1060": {-1.000, -0.000}, "-1440,-960": {-1.000, -0.000}, "-1440,-860": {-1.000, 0.000}, "-1440,-760": {-1.000, 0.000}, "-1440,-660": {-1.000, 0.000}, "-1440,-560": {-1.000, 0.000}, "-1440,-460": {-1.000, 0.000}, "-1440,-360": {-1.000, 0.000}, "-1440,-260": {-1.000, -0.000}, "-1440,1240": {-1.000, 0.010}, "-1440,1340": {-1.000, 0.010}, "-1440,1440": {-1.000, 0.010}, "-1440,1540": {-1.000, 0.000}, "-1440,1640": {-1.000, 0.000}, "-1440,1740": {-1.000, 0.000}, "-1440,1840": {-1.000, 0.000}, "-1440,1940": {-1.000, 0.000}, "-1440,2040": {-1.000, 0.000}, "-1440,2140": {-1.000, 0.000}, "-1440,2240": {-1.000, 0.000}, "-1440,2340": {-1.000, 0.000}, "-1440,2440": {-1.000, 0.000}, "-1440,2540": {-1.000, 0.000}, "-1440,2640": {-1.000, 0.000}, "-1440,2740": {-1.000, 0.000}, "-1440,2840": {-1.000, 0.000}, "-1440,2940": {-1.000, 0.000}, "-1440,3040": {-1.000, 0.000}, "-1440,3140": {-1.000, 0.000}, "-1440,3240": {-1.000, 0.000}, "-1440,3340": {-1.000, 0.000}, "-1340,-1860": {-1.000, 0.005}, "-1340,-1760": {-1.000, 0.005}, "-1340,-1660": {-1.000, -0.000}, "-1340,-1560": {-1.000, -0.000}, "-1340,-1460": {-1.000, -0.000}, "-1340,-1360": {-1.000, -0.000}, "-1340,-1260": {-1.000, -0.000}, "-1340,-1160": {-1.000, -0.000}, "-1340,-1060": {-1.000, -0.000}, "-1340,-960": {-1.000, 0.000}, "-1340,-860": {-1.000, 0.000}, "-1340,-760": {-1.000, 0.000}, "-1340,-660": {-1.000, 0.000}, "-1340,-560": {-1.000, 0.000}, "-1340,-460": {-1.000, 0.000}, "-1340,-360": {-1.000, -0.000}, "-1340,-260": {-1.000, -0.000}, "-1340,1240": {-1.000, 0.010}, "-1340,1340": {-1.000, 0.010}, "-1340,1440": {-1.000, 0.010}, "-1340,1540": {-1.000, 0.000}, "-1340,1640": {-1.000, 0.000}, "-1340,1740": {-1.000, 0.000}, "-1340,1840": {-1.000, 0.000}, "-1340,1940": {-1.000, 0.000}, "-1340,2040": {-1.000, 0.000}, "-1340,2140": {-1.000, 0.000}, "-1340,2240": {-1.000, 0.000}, "-1340,2340": {-1.000, 0.000}, "-1340,2440": {-1.000, 0.000}, "-1340,2540": {-1.000, 0.000}, "-1340,2640": {-1.000, 0.000}, "-1340,2740": {-1.000, 0.000}, "-1340,2840": {-1.000, 0.000}, "-1340,2940": {-1.000, 0.000}, "-1340,3040": {-1.000, 0.000}, "-1340,3140": {-1.000, 0.000}, "-1340,3240": {-1.000, 0.000}, "-1340,3340": {-1.000, 0.000}, "-1240,-1860": {-1.000, -0.000}, "-1240,-1760": {-1.000, -0.000}, "-1240,-1660": {-1.000, -0.000}, "-1240,-1560": {-1.000, -0.000}, "-1240,-1460": {-1.000, -0.000}, "-1240,-1360": {-1.000, -0.000}, "-1240,-1260": {-1.000, -0.000}, "-1240,-1160": {-1.000, -0.000}, "-1240,-1060": {-1.000, -0.000}, "-1240,-960": {-1.000, 0.000}, "-1240,-860": {-1.000, 0.000}, "-1240,-760": {-1.000, 0.000}, "-1240,-660": {-1.000, 0.000}, "-1240,-560": {-1.000, 0.000}, "-1240,-460": {-1.000, 0.000}, "-1240,-360": {-1.000, -0.000}, "-1240,-260": {-1.000, -0.000}, "-1240,1240": {-1.000, 0.010}, "-1240,1340": {-1.000, 0.010}, "-1240,1440": {-1.000, 0.010}, "-1240,1540": {-1.000, 0.000}, "-1240,1640": {-1.000, 0.000}, "-1240,1740": {-1.000, 0.000}, "-1240,1840": {-1.000, 0.000}, "-1240,1940": {-1.000, 0.000}, "-1240,2040": {-1.000, 0.000}, "-1240,2140": {-1.000, 0.000}, "-1240,2240": {-1.000, 0.000}, "-1240,2340": {-1.000, 0.000}, "-1240,2440": {-1.000, 0.000}, "-1240,2540": {-1.000, 0.000}, "-1240,2640": {-1.000, 0.000}, "-1240,2740": {-1.000, 0.000}, "-1240,2840": {-1.000, 0.000}, "-1240,2940": {-1.000, 0.000}, "-1240,3040": {-1.000, 0.000}, "-1240,3140": {-1.000, 0.000}, "-1240,3240": {-1.000, 0.000}, "-1240,3340": {-1.000, 0.000}, "-1140,-1860": {-1.000, -0.000}, "-1140,-1760": {-1.000, -0.000}, "-1140,-1660": {-1.000, -0.000}, "-1140,-1560": {-1.000, -0.000}, "-1140,-1460": {-1.000, -0.000}, "-1140,-1360": {-1.000, -0.000}, "-1140,-1260": {-1.000, -0.000}, "-1140,-1160": {-1.000, -0.000}, "-1140,-1060": {-1.000, -0.000}, "-1140,-960": {-1.000, -0.000}, "-1140,-860": {-1.000, 0.000}, "-1140,-760": {-1.000, 0.000}, "-1140,-660": {-1.000, 0.000}, "-1140,-560": {-1.000, 0.000}, "-1140,-460": {-1.000, 0.000}, "-1140,-360": {-1.000, -0.000}, "-1140,-260": {-1.000, -0.000}, "-1140,1240": {-1.000, 0.010}, "-1140,1340": {-1.000, 0.010}, "-1140,1440": {-1.000, 0.010}, "-1140,1540": {-1.000, 0.000}, "-1140,1640": {-1.000, 0.000}, "-1140,1740": {-1.000, 0.000}, "-1140,1840": {-1.000, 0.000}, "-1140,1940": {-1.000, 0.000}, "-1140,2040": {-1.000, 0.000}, "-1140,2140": {-1.000, 0.000}, "-1140,2240": {-1.000, 0.000}, "-1140,2340": {-1.000, 0.000}, "-1140,2440": {-1.000, 0.000}, "-1140,2540": {-1.000, 0.000}, "-1140,2640": {-1.000, 0.000}, "-1140,2740": {-1.000, 0.000}, "-1140,2840": {-1.000, 0.000}, "-1140,2940": {-1.000, 0.000}, "-1140,3040": {-1.000, 0.000}, "-1140,3140": {-1.000, 0.000}, "-1140,3240": {-1.000, 0.000}, "-1140,3340": {-1.000, 0.000}, "-1040,-1860": {-1.000, -0.000}, "-1040,-1760": {-1.000, -0.000}, "-1040,-1660": {-1.000, -0.000}, "-1040,-1560": {-1.000, -0.000}, "-1040,-1460": {-1.000, -0.000}, "-1040,-1360": {-1.000, -0.000}, "-1040,-1260": {-1.000, -0.000}, "-1040,-1160": {-1.000, -0.000}, "-1040,-1060": {-1.000, -0.000}, "-1040,-960": {-1.000, -0.000}, "-1040,-860": {-1.000, -0.000}, "-1040,-760": {-1.000, 0.000}, "-1040,-660": {-1.000, 0.000}, "-1040,-560": {-1.000, 0.000}, "-1040,-460": {-1.000, -0.000}, "-1040,-360": {-1.000, -0.000}, "-1040,-260": {-1.000, -0.000}, "-1040,1240": {-1.000, 0.010}, "-1040,1340": {-1.000, 0.010}, "-1040,1440": {-1.000, 0.010}, "-1040,1540": {-1.000, 0.000}, "-1040,1640": {-1.000, 0.000}, "-1040,1740": {-1.000, 0.000}, "-1040,1840": {-1.000, 0.000}, "-1040,1940": {-1.000, 0.000}, "-1040,2040": {-1.000, 0.000}, "-1040,2140": {-1.000, 0.000}, "-1040,2240": {-1.000, 0.000}, "-1040,2340": {-1.000, 0.000}, "-1040,2440": {-1.000, 0.000}, "-1040,2540": {-1.000, 0.000}, "-1040,2640": {-1.000, 0.000}, "-1040,2740": {-1.000, 0.000}, "-1040,2840": {-1.000, 0.000}, "-1040,2940": {-1.000, 0.000}, "-1040,3040": {-1.000, 0.000}, "-1040,3140": {-1.000, 0.000}, "-1040,3240": {-1.000, 0.000}, "-1040,3340": {-1.000, 0.000}, "-940,-1860": {-1.000, -0.000}, "-940,-1760": {-1.000, -0.000}, "-940,-1660": {-1.000, -0.000}, "-940,-1560": {-1.000, -0.000}, "-940,-1460": {-1.000, -0.000}, "-940,-1360": {-1.000, -0.000}, "-940,-1260": {-1.000, -0.000}, "-940,-1160": {-1.000, -0.000}, "-940,-1060": {-1.000, -0.000}, "-940,-960": {-1.000, -0.000}, "-940,-860": {-1.000, -0.000}, "-940,-760": {-1.000, -0.000}, "-940,-660": {-1.000, 0.000}, "-940,-560": {-1.000, -0.000}, "-940,-460": {-1.000, -0.000}, "-940,-360": {-1.000, -0.000}, "-940,-260": {-1.000, -0.000}, "-940,1240": {-1.000, 0.010}, "-940,1340": {-1.000, 0.010}, "-940,1440": {-1.000, 0.010}, "-940,1540": {-1.000, 0.000}, "-940,1640": {-1.000, 0.000}, "-940,1740": {-1.000, 0.000}, "-940,1840": {-1.000, 0.000}, "-940,1940": {-1.000, 0.000}, "-940,2040": {-1.000, 0.000}, "-940,2140": {-1.000, 0.000}, "-940,2240": {-1.000, 0.000}, "-940,2340": {-1.000, 0.000}, "-940,2440": {-1.000, 0.000}, "-940,2540": {-1.000, 0.000}, "-940,2640": {-1.000, 0.000}, "-940,2740": {-1.000, 0.000}, "-940,2840": {-1.000, 0.000}, "-940,2940": {-1.000, 0.000}, "-940,3040": {-1.000, 0.000}, "-940,3140": {-1.000, 0.000}, "-940,3240": {-1.000, 0.000}, "-940,3340": {-1.000, 0.000}, "-840,-1860": {-1.000, -0.000}, "-840,-1760": {-1.000, -0.000}, "-840,-1660": {-1.000, -0.000}, "-840,-1560": {-1.000, -0.000}, "-840,-1460": {-1.000, -0.000}, "-840,-1360": {-1.000, -0.000}, "-840,-1260": {-1.000, -0.000}, "-840,-1160": {-1.000, -0.000}, "-840,-1060": {-1.000, -0.000}, "-840,-960": {-1.000, -0.000}, "-840,-860": {-1.000, -0.000}, "-840,-760": {-1.000, -0.000}, "-840,-660": {-1.000, -0.000}, "-840,-560": {-1.000, -0.000}, "-840,-460": {-1.000, -0.000}, "-840,-360": {-1.000, -0.000}, "-840,-260": {-1.000, -0.000}, "-840,1240": {-1.000, 0.010}, "-840,1340": {-1.000, 0.010}, "-840,1440": {-1.000, 0.010}, "-840,1540": {-1.000, 0.000}, "-840,1640": {-1.000, 0.000}, "-840,1740": {-1.000, 0.000}, "-840,1840": {-1.000, 0.000}, "-840,1940": {-1.000, 0.000}, "-840,2040": {-1.000, 0.000}, "-840,2140": {-1.000, 0.000}, "-840,2240": {-1.000, 0.000}, "-840,2340": {-1.000, 0.000}, "-840,2440": {-1.000, 0.000}, "-840,2540": {-1.000, 0.000}, "-840,2640": {-1.000, 0.000}, "-840,2740": {-1.000, 0.000}, "-840,2840": {-1.000, 0.000}, "-840,2940": {-1.000, 0.000}, "-840,3040": {-1.000, 0.000}, "-840,3140": {-1.000, 0.000}, "-840,3240": {-1.000, 0.000}, "-840,3340": {-1.000, 0.000}, "-740,-1860": {-1.000, -0.000}, "-740,-1760": {-1.000, -0.000}, "-740,-1660": {-1.000, -0.000}, "-740,-1560": {-1.000, -0.000}, "-740,-1460": {-1.000, -0.000}, "-740,-1360": {-1.000, -0.000}, "-740,-1260": {-1.000, -0.000}, "-740,-1160": {-1.000, -0.000}, "-740,-1060": {-1.000, -0.000}, "-740,-960": {-1.000, -0.000}, "-740,-860": {-1.000, -0.000}, "-740,-760": {-1.000, -0.000}, "-740,-660": {-1.000, -0.000}, "-740,-560": {-1.000, -0.000}, "-740,-460": {-1.000, -0.000}, "-740,-360": {-1.000, -0.000}, "-740,-260": {-1.000, -0.000}, "-740,1240": {-1.000, 0.010}, "-740,1340": {-1.000, 0.010}, "-740,1440": {-1.000, 0.010}, "-740,1540": {-1.000, 0.000}, "-740,1640": {-1.000, 0.000}, "-740,1740": {-1.000, 0.000}, "-740,1840": {-1.000, 0.000}, "-740,1940": {-1.000, 0.000}, "-740,2040": {-1.000, 0.000}, "-740,2140": {-1.000, 0.000}, "-740,2240": {-1.000, 0.000}, "-740,2340": {-1.000, 0.000}, "-740,2440": {-1.000, 0.000}, "-740,2540": {-1.000, 0.000}, "-740,2640": {-1.000, 0.000}, "-740,2740": {-1.000, 0.000}, "-740,2840": {-1.000, 0.000}, "-740,2940": {-1.000, 0.000}, "-740,3040": {-1.000, 0.000}, "-740,3140": {-1.000, 0.000}, "-740,3240": {-1.000, 0.000}, "-740,3340": {-1.000, 0.000}, "-640,-1860": {-1.000, -0.000}, "-640,-1760": {-1.000, -0.000}, "-640,-1660": {-1.000, -0.000}, "-640,-1560": {-1.000, -0.000}, "-640,-1460": {-1.000, -0.000}, "-640,-1360": {-1.000, -0.000}, "-640,-1260": {-1.000, -0.000}, "-640,-1160": {-1.000, -0.000}, "-640,-1060": {-1.000, -0.000}, "-640,-960": {-1.000, -0.000}, "-640,-860": {-1.000, -0.000}, "-640,-760": {-1.000, -0.000}, "-640,-660": {-1.000, -0.000}, "-640,-560": {-1.000, -0.000}, "-640,-460": {-1.000, -0.000}, "-640,-360": {-1.000, -0.000}, "-640,-260": {-1.000, -0.000}, "-640,1240": {-1.000, 0.000}, "-640,1340": {-1.000, 0.000}, "-640,1440": {-1.000, 0.000}, "-640,1540": {-1.000, 0.000}, "-640,1640": {-1.000, 0.000}, "-640,1740": {-1.000, 0.000}, "-640,1840": {-1.000, 0.000}, "-640,1940": {-1.000, 0.000}, "-640,2040": {-1.000, 0.000}, "-640,2140": {-1.000, 0.000}, "-640,2240": {-1.000, 0.000}, "-640,2340": {-1.000, 0.000}, "-640,2440": {-1.000, 0.000}, "-640,2540": {-1.000, 0.000}, "-640,2640": {-1.000, 0.000}, "-640,2740": {-1.000, 0.000}, "-640,2840": {-1.000, 0.000}, "-640,2940": {-1.000, 0.000}, "-640,3040": {-1.000, 0.000}, "-640,3140": {-1.000, 0.000}, "-640,3240": {-1.000, 0.000}, "-640,3340": {-1.000, 0.000}, "-540,-1860": {-1.000, -0.000}, "-540,-1760": {-1.000, -0.000}, "-540,-1660": {-1.000, -0.000}, "-540,-1560": {-1.000, -0.000}, "-540,-1460": {-1.000, -0.000}, "-540,-1360": {-1.000, -0.000}, "-540,-1260": {-1.000, -0.000}, "-540,-1160": {-1.000, -0.000}, "-540,-1060": {-1.000, -0.000}, "-540,-960": {-1.000, -0.000}, "-540,-860": {-1.000, -0.000}, "-540,-760": {-1.000, -0.000}, "-540,-660": {-1.000, -0.000}, "-540,-560": {-1.000, -0.000}, "-540,-460": {-1.000, -0.000}, "-540,-360": {-1.000, -0.000}, "-540,-260": {-1.000, -0.000}, "-540,1240": {-1.000, 0.000}, "-540,1340": {-1.000, 0.000}, "-540,1440": {-1.000, 0.000}, "-540,1540": {-1.000, 0.000}, "-540,1640": {-1.000, 0.000}, "-540,1740": {-1.000, 0.000}, "-540,1840": {-1.000, 0.000}, "-540,1940": {-1.000, 0.000}, "-540,2040": {-1.000, 0.000}, "-540,2140": {-1.000, 0.000}, "-540,2240": {-1.000, 0.000}, "-540,2340": {-1.000, 0.000}, "-540,2440": {-1.000, 0.000}, "-540,2540": {-1.000, 0.000}, "-540,2640": {-1.000, 0.000}, "-540,2740": {-1.000, 0.000}, "-540,2840": {-1.000, 0.000}, "-540,2940": {-1.000, 0.000}, "-540,3040": {-1.000, 0.000}, "-540,3140": {-1.000, 0.000}, "-540,3240": {-1.000, 0.000}, "-540,3340": {-1.000, 0.000}, "-440,-1860": {-1.000, -0.000}, "-440,-1760": {-1.000, -0.000}, "-440,-1660": {-1.000, -0.000}, "-440,-1560": {-1.000, -0.000}, "-440,-1460": {-1.000, -0.000}, "-440,-1360": {-1.000, -0.000}, "-440,-1260": {-1.000, -0.000}, "-440,-1160": {-1.000, -0.000}, "-440,-1060": {-1.000, -0.000}, "-440,-960": {-1.000, -0.000}, "-440,-860": {-1.000, -0.000}, "-440,-760": {-1.000, -0.000}, "-440,-660": {-1.000, -0.000}, "-440,-560": {-1.000, -0.000}, "-440,-460": {-1.000, -0.000}, "-440,-360": {-1.000, -0.000}, "-440,-260": {-1.000, -0.000}, "-440,1240": {-1.000, 0.000}, "-440,1340": {-1.000, 0.000}, "-440,1440": {-1.000, 0.000}, "-440,1540": {-1.000, 0.000}, "-440,1640": {-1.000, 0.000}, "-440,1740": {-1.000, 0.000}, "-440,1840": {-1.000, 0.000}, "-440,1940": {-1.000, 0.000}, "-440,2040": {-1.000, 0.000}, "-440,2140": {-1.000, 0.000}, "-440,2240": {-1.000, 0.000}, "-440,2340": {-1.000, 0.000}, "-440,2440": {-1.000, 0.000}, "-440,2540": {-1.000, 0.000}, "-440,2640": {-1.000, 0.000}, "-440,2740": {-1.000, 0.000}, "-440,2840": {-1.000, 0.000}, "-440,2940": {-1.000, 0.000}, "-440,3040": {-1.000, 0.000}, "-440,3140": {-1.000, 0.000}, "-440,3240": {-1.000, 0.000}, "-440,3340": {-1.000, 0.000}, "-340,-1860": {-1.000, -0.000}, "-340,-1760": {-1.000, -0.000}, "-340,-1660": {-1.000, -0.000}, "-340,-1560": {-1.000, -0.000}, "-340,-1460": {-1.000, -0.000}, "-340,-1360": {-1.000, -0.000}, "-340,-1260": {-1.000, -0.000}, "-340,-1160": {-1.000, -0.000}, "-340,-1060": {-1.000, -0.000}, "-340,-960": {-1.000, -0.000}, "-340,-860": {-1.000, -0.000}, "-340,-760": {-1.000, -0.000}, "-340,-660": {-1.000, -0.000}, "-340,-560": {-1.000, -0.000}, "-340,-460": {-1.000, -0.000}, "-340,-360": {-1.000, -0.000}, "-340,-260": {-1.000, -0.000}, "-340,1240": {-1.000, 0.000}, "-340,1340": {-1.000, 0.000}, "-340,1440": {-1.000, 0.000}, "-340,1540": {-1.000, 0.000}, "-340,1640": {-1.000, 0.000}, "-340,1740": {-1.000, 0.000}, "-340,1840": {-1.000, 0.000}, "-340,1940": {-1.000, 0.000}, "-340,2040": {-1.000, 0.000}, "-340,2140": {-1.000, 0.000}, "-340,2240": {-1.000, 0.000}, "-340,2340": {-1.000, 0.000}, "-340,2440": {-1.000, 0.000}, "-340,2540": {-1.000, 0.000}, "-340,2640": {-1.000, 0.000}, "-340,2740": {-1.000, 0.000}, "-340,2840": {-1.000, 0.000}, "-340,2940": {-1.000, 0.000}, "-340,3040": {-1.000, 0.000}, "-340,3140": {-1.000, 0.000}, "-340,3240": {-1.000, 0.000}, "-340,3340": {-1.000, 0.000}, "-240,-1860": {-1.000, -0.000}, "-240,-1760": {-1.000, -0.000}, "-240,-1660": {-1.000, -0.000}, "-240,-1560": {-1.000, -0.000}, "-240,-1460": {-1.000, -0.000}, "-240,-1360": {-1.000, -0.000}, "-240,-1260": {-1.000, -0.000}, "-240,-1160": {-1.000, -0.000}, "-240,-1060": {-1.000, -0.000}, "-240,-960": {-1.000, -0.000}, "-240,-860": {-1.000, -0.000}, "-240,-760": {-1.000, -0.000}, "-240,-660": {-1.000, -0.000}, "-240,-560": {-1.000, -0.000}, "-240,-460": {-1.000, -0.000}, "-240,-360": {-1.000, -0.000}, "-240,-260": {-1.000, -0.000}, "-240,1240": {-1.000, 0.000}, "-240,1340": {-1.000, 0.000}, "-240,1440": {-1.000, 0.000}, "-240,1540": {-1.000, 0.000}, "-240,1640": {-1.000, 0.000}, "-240,1740": {-1.000, 0.000}, "-240,1840": {-1.000, 0.000}, "-240,1940": {-1.000, 0.000}, "-240,2040": {-1.000, 0.000}, "-240,2140": {-1.000, 0.000}, "-240,2240": {-1.000, 0.000}, "-240,2340": {-1.000, 0.000}, "-240,2440": {-1.000, 0.000}, "-240,2540": {-1.000, 0.000}, "-240,2640": {-1.000, 0.000}, "-240,2740": {-1.000, 0.000}, "-240,2840": {-1.000, 0.000}, "-240,2940": {-1.000, 0.000}, "-240,3040": {-1.000, 0.000}, "-240,3140": {-1.000, 0.000}, "-240,3240": {-1.000, 0.000}, "-240,3340": {-1.000, 0.000}, "-140,-1860": {-1.000, -0.000}, "-140,-1760": {-1.000, -0.000}, "-140,-1660": {-1.000, -0.000}, "-140,-1560": {-1.000, -0.000}, "-140,-1460": {-1.000, -0.000}, "-140,-1360": {-1.000, -0.000}, "-140,-1260": {-1.000, -0.000}, "-140,-1160": {-1.000, -0.000}, "-140,-1060": {-1.000, -0.000}, "-140,-960": {-1.000, -0.000}, "-140,-860": {-1.000, -0.000}, "-140,-760": {-1.000, -0.000}, "-140,-660": {-1.000, -0.000}, "-140,-560": {-1.000, -0.000}, "-140,-460": {-1.000, -0.000}, "-140,-360": {-1.000, -0.000}, "-140,-260": {-1.000, -0.000}, "-140,1240": {-1.000, 0.000}, "-140,1340": {-1.000, 0.000}, "-140,1440": {-1.000, 0.000}, "-140,1540": {-1.000, 0.000}, "-140,1640": {-1.000, 0.000}, "-140,1740": {-1.000, 0.000}, "-140,1840": {-1.000, 0.000}, "-140,1940": {-1.000, 0.000}, "-140,2040": {-1.000, 0.000}, "-140,2140": {-1.000, 0.000}, "-140,2240": {-1.000, 0.000}, "-140,2340": {-1.000, 0.000}, "-140,2440": {-1.000, 0.000}, "-140,2540": {-1.000, 0.000}, "-140,2640": {-1.000, 0.000}, "-140,2740": {-1.000, 0.000}, "-140,2840": {-1.000, 0.000}, "-140,2940": {-1.000, 0.000}, "-140,3040": {-1.000, 0.000}, "-140,3140": {-1.000, 0.000}, "-140,3240": {-1.000, 0.000}, "-140,3340": {-1.000, 0.000}, "-40,-1860": {-1.000, -0.000}, "-40,-1760": {-1.000, -0.000}, "-40,-1660": {-1.000, -0.000}, "-40,-1560": {-1.000, -0.000}, "-40,-1460": {-1.000, -0.000}, "-40,-1360": {-1.000, -0.000}, "-40,-1260": {-1.000, -0.000}, "-40,-1160": {-1.000, -0.000}, "-40,-1060": {-1.000, -0.000}, "-40,-960": {-1.000, -0.000}, "-40,-860": {-1.000, -0.000}, "-40,-760": {-1.000, -0.000}, "-40,-660": {-1.000, -0.000}, "-40,-560": {-1.000, -0.000}, "-40,-460": {-1.000, -0.000}, "-40,-360": {-1.000, -0.000}, "-40,-260": {-1.000, -0.000}, "-40,1240": {-1.000, 0.000}, "-40,1340": {-1.000, 0.000}, "-40,1440": {-1.000, 0.000}, "-40,1540": {-1.000, 0.000}, "-40,1640": {-1.000, 0.000}, "-40,1740": {-1.000, 0.000}, "-40,1840": {-1.000, 0.000}, "-40,1940": {-1.000, 0.000}, "-40,2040": {-1.000, 0.000}, "-40,2140": {-1.000, 0.000}, "-40,2240": {-1.000, 0.000}, "-40,2340": {-1.000, 0.000}, "-40,2440": {-1.000, 0.000}, "-40,2540": {-1.000, 0.000}, "-40,2640": {-1.000, 0.000}, "-40,2740": {-1.000, 0.000}, "-40,2840": {-1.000, 0.000}, "-40,2940": {-1.000, 0.000}, "-40,3040": {-1.000, 0.000}, "-40,3140": {-1.000, 0.000}, "-40,3240": {-1.000, 0.000}, "-40,3340": {-1.000, 0.000}, "60,-1860": {-1.000, -0.000}, "60,-1760": {-1.000, -0.000}, "60,-1660": {-1.000, -0.000}, "60,-1560": {-1.000, -0.000}, "60,-1460": {-1.000, -0.000}, "60,-1360": {-1.000, -0.000}, "60,-1260": {-1.000, -0.000}, "60,-1160": {-1.000, -0.000}, "60,-1060": {-1.000, -0.000}, "60,-960": {-1.000, -0.000}, "60,-860": {-1.000, -0.000}, "60,-760": {-1.000, -0.000}, "60,-660": {-1.000, -0.000}, "60,-560": {-1.000, -0.000}, "60,-460": {-1.000, -0.000}, "60,-360": {-1.000, -0.000}, "60,-260": {-1.000, -0.000}, "60,1240": {-1.000, 0.000}, "60,1340": {-1.000, 0.000}, "60,1440": {-1.000, 0.000}, "60,1540": {-1.000, 0.000}, "60,1640": {-1.000, 0.000}, "60,1740": {-1.000, 0.000}, "60,1840": {-1.000, 0.000}, "60,1940": {-1.000, 0.000}, "60,2040": {-1.000, 0.000}, "60,2140": {-1.000, 0.000}, "60,2240": {-1.000, 0.000}, "60,2340": {-1.000, 0.000}, "60,2440": {-1.000, 0.000}, "60,2540": {-1.000, 0.000}, "60,2640": {-1.000, 0.000}, "60,2740": {-1.000, 0.000}, "60,2840": {-1.000, 0.000}, "60,2940": {-1.000, 0.000}, "60,3040": {-1.000, 0.000}, "60,3140": {-1.000, 0.000}, "60,3240": {-1.000, 0.000}, "60,3340": {-1.000, 0.000}, "160,-1860": {-1.000, -0.000}, "160,-1760": {-1.000, -0.000}, "160,-1660": {-1.000, -0.000}, "160,-1560": {-1.000, -0.000}, "160,-1460": {-1.000, -0.000}, "160,-1360": {-1.000, -0.000}, "160,-1260": {-1.000, -0.000}, "160,-1160": {-1.000, -0.000}, "160,-1060": {-1.000, -0.000}, "160,-960": {-1.000, -0.000}, "160,-860": {-1.000, -0.000}, "160,-760": {-1.000, -0.000}, "160,-660": {-1.000, -0.000}, "160,-560": {-1.000, -0.000}, "160,-460": {-1.000, -0.000}, "160,-360": {-1.000, -0.000}, "160,-260": {-1.000, -0.000}, "160,1240": {-1.000, 0.000}, "160,1340": {-1.000, 0.000}, "160,1440": {-1.000, 0.000}, "160,1540": {-1.000, 0.000}, "160,1640": {-1.000, 0.000}, "160,1740": {-1.000, 0.000}, "160,1840": {-1.000, 0.000}, "160,1940": {-1.000, 0.000}, "160,2040": {-1.000, 0.000}, "160,2140": {-1.000, 0.000}, "160,2240": {-1.000, 0.000}, "160,2340": {-1.000, 0.000}, "160,2440": {-1.000, 0.000}, "160,2540": {-1.000, 0.000}, "160,2640": {-1.000, 0.000}, "160,2740": {-1.000, 0.000}, "160,2840": {-1.000, 0.000}, "160,2940": {-1.000, 0.000}, "160,3040": {-1.000, 0.000}, "160,3140": {-1.000, 0.000}, "160,3240": {-1.000, 0.000}, "160,3340": {-1.000, 0.000}, "260,-1860": {-1.000, -0.000}, "260,-1760": {-1.000, -0.000}, "260,-1660": {-1.000, -0.000}, "260,-1560": {-1.000, -0.000}, "260,-1460": {-1.000, -0.000}, "260,-1360": {-1.000, -0.000}, "260,-1260": {-1.000, -0.000}, "260,-1160": {-1.000, -0.000}, "260,-1060": {-1.000, -0.000}, "260,-960": {-1.000, -0.000}, "260,-860": {-1.000, -0.000}, "260,-760": {-1.000, -0.000}, "260,-660": {-1.000, -0.000}, "260,-560": {-1.000, -0.000}, "260,-460": {-1.000, -0.000}, "260,-360": {-1.000, -0.000}, "260,-260": {-1.000, -0.000}, "260,1240": {-1.000, 0.000}, "260,1340": {-1.000, 0.000}, "260,1440": {-1.000, 0.000}, "260,1540": {-1.000, 0.000}, "260,1640": {-1.000, 0.000}, "260,1740": {-1.000, 0.000}, "260,1840": {-1.000, 0.000}, "260,1940": {-1.000, 0.000}, "260,2040": {-1.000, 0.000}, "260,2140": {-1.000, 0.000}, "260,2240": {-1.000, 0.000}, "260,2340": {-1.000, 0.000}, "260,2440": {-1.000, 0.000}, "260,2540": {-1.000, 0.000}, "260,2640": {-1.000, 0.000}, "260,2740": {-1.000, 0.000}, "260,2840": {-1.000, 0.000}, "260,2940": {-1.000, 0.000}, "260,3040": {-1.000, 0.000}, "260,3140": {-1.000, 0.000}, "260,3240": {-1.000, 0.000}, "260,3340": {-1.000, 0.000}, "360,-1860": {-1.000, -0.000}, "360,-1760": {-1.000, -0.000}, "360,-1660": {-1.000, -0.000}, "360,-1560": {-1.000, -0.000}, "360,-1460": {-1.000, -0.000}, "360,-1360": {-1.000, -0.000}, "360,-1260": {-1.000, -0.000}, "360,-1160": {-1.000, -0.000}, "360,-1060": {-1.000, -0.000}, "360,-960": {-1.000, -0.000}, "360,-860": {-1.000, -0.000}, "360,-760": {-1.000, -0.000}, "360,-660": {-1.000, -0.000}, "360,-560": {-1.000, -0.000}, "360,-460": {-1.000, -0.000}, "360,-360": {-1.000, -0.000}, "360,-260": {-1.000, -0.000}, "360,1240": {-1.000, 0.000}, "360,1340": {-1.000, 0.000}, "360,1440": {-1.000, 0.000}, "360,1540": {-1.000, 0.000}, "360,1640": {-1.000, 0.000}, "360,1740": {-1.000, 0.000}, "360,1840": {-1.000, 0.000}, "360,1940": {-1.000, 0.000}, "360,2040": {-1.000, 0.000}, "360,2140": {-1.000, 0.000}, "360,2240": {-1.000, 0.000}, "360,2340": {-1.000, 0.000}, "360,2440": {-1.000, 0.000}, "360,2540": {-1.000, 0.000}, "360,2640": {-1.000, 0.000}, "360,2740": {-1.000, 0.000}, "360,2840": {-1.000, 0.000}, "360,2940": {-1.000, 0.000}, "360,3040": {-1.000, 0.000}, "360,3140": {-1.000, 0.000}, "360,3240": {-1.000, 0.000}, "360,3340": {-1.000, 0.000}, "460,-1860": {-1.000, -0.000}, "460,-1760": {-1.000, -0.000}, "460,-1660": {-1.000, -0.000}, "460,-1560": {-1.000, -0.000}, "460,-1460": {-1.000, -0.000}, "460,-1360": {-1.000, -0.000}, "460,-1260": {-1.000, -0.000}, "460,-1160": {-1.000, -0.000}, "460,-1060": {-1.000, -0.000}, "460,-960": {-1.000, -0.000}, "460,-860": {-1.000, -0.000}, "460,-760": {-1.000, -0.000}, "460,-660": {-1.000, -0.000}, "460,-560": {-1.000, -0.000}, "460,-460": {-1.000, -0.000}, "460,-360": {-1.000, -0.000}, "460,-260": {-1.000, -0.000}, "460,1240": {-1.000, 0.000}, "460,1340": {-1.000, 0.000}, "460,1440": {-1.000, 0.000}, "460,1540": {-1.000, 0.000}, "460,1640": {-1.000, 0.000}, "460,1740": {-1.000, 0.000}, "460,1840": {-1.000, 0.000}, "460,1940": {-1.000, 0.000}, "460,2040": {-1.000, 0.000}, "460,2140": {-1.000, 0.000}, "460,2240": {-1.000, 0.000}, "460,2340": {-1.000, 0.000}, "460,2440": {-1.000, 0.000}, "460,2540": {-1.000, 0.000}, "460,2640": {-1.000, 0.000}, "460,2740": {-1.000, 0.000}, "460,2840": {-1.000, 0.000}, "460,2940": {-1.000, 0.000}, "460,3040": {-1.000, 0.000}, "460,3140": {-1.000, 0.000}, "460,3240": {-1.000, 0.000}, "460,3340": {-1.000, 0.000}, "560,-1860": {-1.000, -0.000}, "560,-1760": {-1.000, -0.000}, "560,-1660": {-1.000, -0.000}, "560,-1560": {-1.000, -0.000}, "560,-1460": {-1.000, -0.000}, "560,-1360": {-1.000, -0.000}, "560,-1260": {-1.000, -0.000}, "560,-1160": {-1.000, -0.000}, "560,-1060": {-1.000, -0.000}, "560,-960": {-1.000, -0.000}, "560,-860": {-1.000, -0.000}, "560,-760": {-1.000, -0.000}, "560,-660": {-1.000, -0.000}, "560,-560": {-1.000, -0.000}, "560,-460": {-1.000, -0.000}, "560,-360": {-1.000, -0.000}, "560,-260": {-1.000, -0.000}, "560,1240": {-1.000, 0.000}, "560,1340": {-1.000, 0.000}, "560,1440": {-1.000, 0.000}, "560,1540": {-1.000, 0.000}, "560,1640": {-1.000, 0.000}, "560,1740": {-1.000, 0.000}, "560,1840": {-1.000, 0.000}, "560,1940": {-1.000, 0.000}, "560,2040": {-1.000, 0.000}, "560,2140": {-1.000, 0.000}, "560,2240": {-1.000, 0.000}, "560,2340": {-1.000, 0.000}, "560,2440": {-1.000, 0.000}, "560,2540": {-1.000, 0.000}, "560,2640": {-1.000, 0.000}, "560,2740": {-1.000, 0.000}, "560,2840": {-1.000, 0.000}, "560,2940": {-1.000, 0.000}, "560,3040": {-1.000, 0.000}, "560,3140": {-1.000, 0.000}, "560,3240": {-1.000, 0.000}, "560,3340": {-1.000, 0.000}, "660,-1860": {-1.000, -0.000}, "660,-1760": {-1.000, -0.000}, "660,-1660": {-1.000, -0.000}, "660,-1560": {-1.000, -0.000}, "660,-1460": {-1.000, -0.000}, "660,-1360": {-1.000, -0.000}, "660,-1260": {-1.000, -0.000}, "660,-1160": {-1.000, -0.000}, "660,-1060": {-1.000, -0.000}, "660,-960": {-1.000, -0.000}, "660,-860": {-1.000, -0.000}, "660,-760": {-1.000, -0.000}, "660,-660": {-1.000, -0.000}, "660,-560": {-1.000, -0.000}, "660,-460": {-1.000, -0.000}, "660,-360": {-1.000, -0.000}, "660,-260": {-1.000, -0.000}, "660,1240": {-1.000, 0.000}, "660,1340": {-1.000, 0.000}, "660,1440": {-1.000, 0.000}, "660,1540": {-1.000, 0.000}, "660,1640": {-1.000, 0.000}, "660,1740": {-1.000, 0.000}, "660,1840": {-1.000, 0.000}, "660,1940": {-1.000, 0.000}, "660,2040": {-1.000, 0.000}, "660,2140": {-1.000, 0.000}, "660,2240": {-1.000, 0.000}, "660,2340": {-1.000, 0.000}, "660,2440": {-1.000, 0.000}, "660,2540": {-1.000, 0.000}, "660,2640": {-1.000, 0.000}, "660,2740": {-1.000, 0.000}, "660,2840": {-1.000, 0.000}, "660,2940": {-1.000, 0.000}, "660,3040": {-1.000, 0.000}, "660,3140": {-1.000, 0.000}, "660,3240": {-1.000, 0.000}, "660,3340": {-1.000, 0.000}, "760,-1860": {-1.000, -0.000}, "760,-1760": {-1.000, -0.000}, "760,-1660": {-1.000, -0.000}, "760,-1560": {-1.000, -0.000}, "760,-1460": {-1.000, -0.000}, "760,-1360": {-1.000, -0.000}, "760,-1260": {-1.000, -0.000}, "760,-1160": {-1.000, -0.000}, "760,-1060": {-1.000, -0.000}, "760,-960": {-1.000, -0.000}, "760,-860": {-1.000, -0.000}, "760,-760": {-1.000, -0.000}, "760,-660": {-1.000, -0.000}, "760,-560": {-1.000, -0.000}, "760,-460": {-1.000, -0.000}, "760,-360": {-1.000, -0.000}, "760,-260": {-1.000, -0.000}, "760,1240": {-1.000, 0.000}, "760,1340": {-1.000, 0.000}, "760,1440": {-1.000, 0.000}, "760,1540": {-1.000, 0.000}, "760,1640": {-1.000, 0.000}, "760,1740": {-1.000, 0.000}, "760,1840": {-1.000, 0.000}, "760,1940": {-1.000, 0.000}, "760,2040": {-1.000, 0.000}, "760,2140": {-1.000, 0.000}, "760,2240": {-1.000, 0.000}, "760,2340": {-1.000, 0.000}, "760,2440": {-1.000, 0.000}, "760,2540": {-1.000, 0.000}, "760,2640": {-1.000, 0.000}, "760,2740": {-1.000, 0.000}, "760,2840": {-1.000, 0.000}, "760,2940": {-1.000, 0.000}, "760,3040": {-1.000, 0.000}, "760,3140": {-1.000, 0.000}, "760,3240": {-1.000, 0.000}, "760,3340": {-1.000, 0.000}, "860,-1860": {-1.000, -0.000}, "860,-1760": {-1.000, -0.000}, "860,-1660": {-1.000, -0.000}, "860,-1560": {-1.000, -0.000}, "860,-1460": {-1.000, -0.000}, "860,-1360": {-1.000, -0.000}, "860,-1260": {-1.000, -0.000}, "860,-1160": {-1.000, -0.000}, "860,-1060": {-1.000, -0.000}, "860,-960": {-1.000, -0.000}, "860,-860": {-1.000, -0.000}, "860,-760": {-1.000, -0.000}, "860,-660": {-1.000, -0.000}, "860,-560": {-1.000, -0.000}, "860,-460": {-1.000, -0.000}, "860,-360": {-1.000, -0.000}, "860,-260": {-1.000, -0.000}, "860,1240": {-1.000, 0.000}, "860,1340": {-1.000, 0.000}, "860,1440": {-1.000, 0.000}, "860,1540": {-1.000, 0.000}, "860,1640": {-1.000, 0.000}, "860,1740": {-1.000, 0.000}, "860,1840": {-1.000, 0.000}, "860,1940": {-1.000, 0.000}, "860,2040": {-1.000, 0.000}, "860,2140": {-1.000, 0.000}, "860,2240": {-1.000, 0.000}, "860,2340": {-1.000, 0.000}, "860,2440": {-1.000, 0.000}, "860,2540": {-1.000, 0.000}, "860,2640": {-1.000, 0.000}, "860,2740": {-1.000, 0.000}, "860,2840": {-1.000, 0.000}, "860,2940": {-1.000, 0.000}, "860,3040": {-1.000, 0.000}, "860,3140": {-1.000, 0.000}, "860,3240": {-1.000, 0.000}, "860,3340": {-1.000, 0.000}, "960,-1860": {-1.000, -0.000}, "960,-1760": {-1.000, -0.000}, "960,-1660": {-1.000, -0.000}, "960,-1560": {-1.000, -0.000}, "960,-1460": {-1.000, -0.000}, "960,-1360": {-1.000, -0.000}, "960,-1260": {-1.000, -0.000}, "960,-1160": {-1.000, -0.000}, "960,-1060": {-1.000, -0.000}, "960,-960": {-1.000, -0.000}, "960,-860": {-1.000, -0.000}, "960,-760": {-1.000, -0.000}, "960,-660": {-1.000, -0.000}, "960,-560": {-1.000, -0.000}, "960,-460": {-1.000, -0.000}, "960,-360": {-1.000, -0.000}, "960,-260": {-1.000, -0.000}, "960,1240": {-1.000, 0.000}, "960,1340": {-1.000, 0.000}, "960,1440": {-1.000, 0.000}, "960,1540": {-1.000, 0.000}, "960,1640": {-1.000, 0.000}, "960,1740": {-1.000, 0.000}, "960,1840": {-1.000, 0.000}, "960,1940": {-1.000, 0.000}, "960,2040": {-1.000, 0.000}, "960,2140": {-1.000, 0.000}, "960,2240": {-1.000, 0.000}, "960,2340": {-1.000, 0.000}, "960,2440": {-1.000, 0.000}, "960,2540": {-1.000, 0.000}, "960,2640": {-1.000, 0.000}, "960,2740": {-1.000, 0.000}, "960,2840": {-1.000, 0.000}, "960,2940": {-1.000, 0.000}, "960,3040": {-1.000, 0.000}, "960,3140": {-1.000, 0.000}, "960,3240": {-1.000, 0.000}, "960,3340": {-1.000, 0.000}, "1060,-1860": {-1.000, -0.000}, "1060,-1760": {-1.000, -0.000}, "1060,-1660": {-1.000, -0.000}, "1060,-1560": {-1.000, -0.000}, "1060,-1460": {-1.000, -0.000}, "1060,-1360": {-1.000, -0.000}, "1060,-1260": {-1.000, -0.000}, "1060,-1160": {-1.000, -0.000}, "1060,-1060": {-1.000, -0.000}, "1060,-960": {-1.000, -0.000}, "1060,-860": {-1.000, -0.000}, "1060,-760": {-1.000, -0.000}, "1060,-660": {-1.000, -0.000}, "1060,-560": {-1.000, -0.000}, "1060,-460": {-1.000, -0.000}, "1060,-360": {-1.000, -0.000}, "1060,-260": {-1.000, -0.000}, "1060,1240": {-1.000, 0.000}, "1060,1340": {-1.000, 0.000}, "1060,1440": {-1.000, 0.000}, "1060,1540": {-1.000, 0.000}, "1060,1640": {-1.000, 0.000}, "1060,1740": {-1.000, 0.000}, "1060,1840": {-1.000, 0.000}, "1060,1940": {-1.000, 0.000}, "1060,2040": {-1.000, 0.000}, "1060,2140": {-1.000, 0.000}, "1060,2240": {-1.000, 0.000}, "1060,2340": {-1.000, 0.000}, "1060,2440": {-1.000, 0.000}, "1060,2540": {-1.000, 0.000}, "1060,2640": {-1.000, 0.000}, "1060,2740": {-1.000, 0.000}, "1060,2840": {-1.000, 0.000}, "1060,2940": {-1.000, 0.000}, "1060,3040": {-1.000, 0.000}, "1060,3140": {-1.000, 0.000}, "1060,3240": {-1.000, 0.000}, "1060,3340": {-1.000, 0.000}, "1160,-1860": {-1.000, -0.000}, "1160,-1760": {-1.000, -0.000}, "1160,-1660": {-1.000, -0.000}, "1160,-1560": {-1.000, -0.000}, "1160,-1460": {-1.000, -0.000}, "1160,-1360": {-1.000, -0.000}, "1160,-1260": {-1.000, -0.000}, "1160,-1160": {-1.000, -0.000}, "1160,-1060": {-1.000, -0.000}, "1160,-960": {-1.000, -0.000}, "1160,-860": {-1.000, -0.000}, "1160,-760": {-1.000, -0.000}, "1160,-660": {-1.000, -0.000}, "1160,-560": {-1.000, -0.000}, "1160,-460": {-1.000, -0.000}, "1160,-360": {-1.000, -0.000}, "1160,-260": {-1.000, -0.000}, "1160,1240": {-1.000, 0.000}, "1160,1340": {-1.000, 0.000}, "1160,1440": {-1.000, 0.000}, "1160,1540": {-1.000, 0.000}, "1160,1640": {-1.000, 0.000}, "1160,1740": {-1.000, 0.000}, "1160,1840": {-1.000, 0.000}, "1160,1940": {-1.000, 0.000}, "1160,2040": {-1.000, 0.000}, "1160,2140": {-1.000, 0.000}, "1160,2240": {-1.000, 0.000}, "1160,2340": {-1.000, 0.000}, "1160,2440": {-1.000, 0.000}, "1160,2540": {-1.000, 0.000}, "1160,2640": {-1.000, 0.000}, "1160,2740": {-1.000, 0.000}, "1160,2840": {-1.000, 0.000}, "1160,2940": {-1.000, 0.000}, "1160,3040": {-1.000, 0.000}, "1160,3140": {-1.000, 0.000}, "1160,3240": {-1.000, 0.000}, "1160,3340": {-1.000, 0.000}, "1260,-1860": {-1.000, -0.000}, "1260,-1760": {-1.000, -0.000}, "1260,-1660": {-1.000, -0.000}, "1260,-1560": {-1.000, -0.000}, "1260,-1460": {-1.000, -0.000}, "1260,-1360": {-1.000, -0.000}, "1260,-1260": {-1.000, -0.000}, "1260,-1160": {-1.000, -0.000}, "1260,-1060": {-1.000, -0.000}, "1260,-960": {-1.000, -0.000}, "1260,-860": {-1.000, -0.000}, "1260,-760": {-1.000, -0.000}, "1260,-660": {-1.000, -0.000}, "1260,-560": {-1.000, -0.000}, "1260,-460": {-1.000, -0.000}, "1260,-360": {-1.000, -0.000}, "1260,-260": {-1.000, -0.000}, "1260,1240": {-1.000, 0.000}, "1260,1340": {-1.000, 0.000}, "1260,1440": {-1.000, 0.000}, "1260,1540": {-1.000, 0.000}, "1260,1640": {-1.000, 0.020}, "1260,1740": {-1.000, 0.020}, "1260,1840": {-1.000, 0.020}, "1260,1940": {-1.000, 0.000}, "1260,2040": {-1.000, 0.000}, "1260,2140": {-1.000, 0.000}, "1260,2240": {-1.000, 0.000}, "1260,2340": {-1.000, 0.000}, "1260,2440": {-1.000, 0.000}, "1260,2540": {-1.000, 0.000}, "1260,2640": {-1.000, 0.000}, "1260,2740": {-1.000, 0.000}, "1260,2840": {-1.000, 0.000}, "1260,2940": {-1.000, 0.000}, "1260,3040": {-1.000, 0.000}, "1260,3140": {-1.000, 0.000}, "1260,3240": {-1.000, 0.000}, "1260,3340": {-1.000, 0.000}, "1360,-1860": {-1.000, -0.000}, "1360,-1760": {-1.000, -0.000}, "1360,-1660": {-1.000, -0.000}, "1360,-1560": {-1.000, -0.000}, "1360,-1460": {-1.000, -0.000}, "1360,-1360": {-1.000, -0.000}, "1360,-1260": {-1.000, -0.000}, "1360,-1160": {-1.000, -0.000}, "1360,-1060": {-1.000, -0.000}, "1360,-960": {-1.000, -0.000}, "1360,-860": {-1.000, -0.000}, "1360,-760": {-1.000, -0.000}, "1360,-660": {-1.000, -0.000}, "1360,-560": {-1.000, -0.000}, "1360,-460": {-1.000, -0.000}, "1360,-360": {-1.000, -0.000}, "1360,-260": {-1.000, -0.000}, "1360,1240": {-0.999, 0.033}, "1360,1340": {-0.999, 0.033}, "1360,1440": {-0.999, 0.033}, "1360,1540": {-1.000, 0.020}, "1360,1640": {-1.000, 0.020}, "1360,1740": {-1.000, 0.020}, "1360,1840": {-1.000, 0.020}, "1360,1940": {-1.000, 0.020}, "1360,2040": {-1.000, 0.000}, "1360,2140": {-1.000, 0.000}, "1360,2240": {-1.000, 0.000}, "1360,2340": {-1.000, 0.000}, "1360,2440": {-1.000, 0.000}, "1360,2540": {-1.000, 0.000}, "1360,2640": {-1.000, 0.000}, "1360,2740": {-1.000, 0.000}, "1360,2840": {-1.000, 0.000}, "1360,2940": {-1.000, 0.000}, "1360,3040": {-1.000, 0.000}, "1360,3140": {-1.000, 0.000}, "1360,3240": {-1.000, 0.000}, "1360,3340": {-1.000, 0.000}, "1460,-1860": {-1.000, -0.000}, "1460,-1760": {-1.000, -0.000}, "1460,-1660": {-1.000, -0.000}, "1460,-1560": {-1.000, -0.000}, "1460,-1460": {-1.000, -0.000}, "1460,-1360": {-1.000, -0.000}, "1460,-1260": {-1.000, -0.000}, "1460,-1160": {-1.000, -0.000}, "1460,-1060": {-1.000, -0.000}, "1460,-960": {-1.000, -0.000}, "1460,-860": {-1.000, -0.000}, "1460,-760": {-1.000, -0.000}, "1460,-660": {-1.000, -0.000}, "1460,-560": {-1.000, -0.000}, "1460,-460": {-1.000, -0.000}, "1460,-360": {-1.000, -0.000}, "1460,-260": {-1.000, -0.000}, "1460,1240": {-0.999, 0.033}, "1460,1340": {-0.999, 0.033}, "1460,1440": {-0.999, 0.033}, "1460,1540": {-1.000, 0.020}, "1460,1640": {-1.000, 0.020}, "1460,1740": {-1.000, 0.020}, "1460,1840": {-1.000, 0.020}, "1460,1940": {-1.000, 0.020}, "1460,2040": {-1.000, 0.000}, "1460,2140": {-1.000, 0.000}, "1460,2240": {-1.000, 0.000}, "1460,2340": {-1.000, 0.000}, "1460,2440": {-1.000, 0.000}, "1460,2540": {-1.000, 0.000}, "1460,2640": {-1.000, 0.000}, "1460,2740": {-1.000, 0.000}, "1460,2840": {-1.000, 0.000}, "1460,2940": {-1.000, 0.000}, "1460,3040": {-1.000, 0.000}, "1460,3140": {-1.000, 0.000}, "1460,3240": {-1.000, 0.000}, "1460,3340": {-1.000, 0.000}, "1560,-1860": {-1.000, -0.000}, "1560,-1760": {-1.000, -0.000}, "1560,-1660": {-1.000, -0.000}, "1560,-1560": {-1.000, -0.000}, "1560,-1460": {-1.000, -0.000}, "1560,-1360": {-1.000, -0.000}, "1560,-1260": {-1.000, -0.000}, "1560,-1160": {-1.000, -0.000}, "1560,-1060": {-1.000, -0.000}, "1560,-960": {-1.000, -0.000}, "1560,-860": {-1.000, -0.000}, "1560,-760": {-1.000, -0.000}, "1560,-660": {-1.000, -0.000}, "1560,-560": {-1.000, -0.000}, "1560,-460": {-1.000, -0.000}, "1560,-360": {-1.000, -0.000}, "1560,-260": {-1.000, -0.000}, "1560,1240": {-0.999, 0.033}, "1560,1340": {-0.999, 0.033}, "1560,1440": {-0.999, 0.033}, "1560,1540": {-1.000, 0.020}, "1560,1640": {-1.000, 0.020}, "1560,1740": {-1.000, 0.020}, "1560,1840": {-1.000, 0.020}, "1560,1940": {-1.000, 0.020}, "1560,2040": {-1.000, 0.000}, "1560,2140": {-1.000, 0.000}, "1560,2240": {-1.000, 0.000}, "1560,2340": {-1.000, 0.000}, "1560,2440": {-1.000, 0.000}, "1560,2540": {-1.000, 0.000}, "1560,2640": {-1.000, 0.000}, "1560,2740": {-1.000, 0.000}, "1560,2840": {-1.000, 0.000}, "1560,2940": {-1.000, 0.000}, "1560,3040": {-1.000, 0.000}, "1560,3140": {-1.000, 0.000}, "1560,3240": {-1.000, 0.000}, "1560,3340": {-1.000, 0.000}, "1660,-1860": {-1.000, -0.000}, "1660,-1760": {-1.000, -0.000}, "1660,-1660": {-1.000, -0.000}, "1660,-1560": {-1.000, -0.000}, "1660,-1460": {-1.000, -0.000}, "1660,-1360": {-1.000, -0.000}, "1660,-1260": {-1.000, -0.000}, "1660,-1160": {-1.000, -0.000}, "1660,-1060": {-1.000, -0.000}, "1660,-960": {-1.000, -0.000}, "1660,-860": {-1.000, -0.000}, "1660,-760": {-1.000, -0.000}, "1660,-660": {-1.000, -0.000}, "1660,-560": {-1.000, -0.000}, "1660,-460": {-1.000, -0.000}, "1660,-360": {-1.000, -0.000}, "1660,-260": {-1.000, -0.027}, "1660,1240": {-0.983, 0.183}, "1660,1340": {-0.983, 0.183}, "1660,1440": {-0.983, 0.183}, "1660,1540": {-1.000, 0.020}, "1660,1640": {-1.000, 0.020}, "1660,1740": {-1.000, 0.020}, "1660,1840": {-1.000, 0.020}, "1660,1940": {-1.000, 0.020}, "1660,2040": {-1.000, 0.019}, "1660,2140": {-1.000, 0.019}, "1660,2240": {-1.000, 0.019}, "1660,2340": {-1.000, 0.019}, "1660,2440": {-1.000, 0.019}, "1660,2540": {-1.000, 0.019}, "1660,2640": {-1.000, 0.019}, "1660,2740": {-1.000, 0.019}, "1660,2840": {-1.000, 0.019}, "1660,2940": {-1.000, 0.000}, "1660,3040": {-1.000, 0.000}, "1660,3140": {-1.000, 0.000}, "1660,3240": {-1.000, 0.000}, "1660,3340": {-1.000, 0.000}, "1760,-1860": {-1.000, -0.000}, "1760,-1760": {-1.000, -0.000}, "1760,-1660": {-1.000, -0.000}, "1760,-1560": {-1.000, -0.000}, "1760,-1460": {-1.000, -0.000}, "1760,-1360": {-1.000, -0.000}, "1760,-1260": {-1.000, -0.000}, "1760,-1160": {-1.000, -0.000}, "1760,-1060": {-1.000, -0.000}, "1760,-960": {-1.000, -0.000}, "1760,-860": {-1.000, -0.000}, "1760,-760": {-1.000, -0.000}, "1760,-660": {-1.000, -0.000}, "1760,-560": {-1.000, -0.000}, "1760,-460": {-1.000, -0.000}, "1760,-360": {-1.000, -0.000}, "1760,-260": {-1.000, -0.027}, "1760,1240": {-0.983, 0.183}, "1760,1340": {-0.983, 0.183}, "1760,1440": {-0.983, 0.183}, "1760,1540": {-0.988, 0.152}, "1760,1640": {-0.988, 0.152}, "1760,1740": {-0.988, 0.152}, "1760,1840": {-0.988, 0.152}, "1760,1940": {-0.988, 0.152}, "1760,2040": {-1.000, 0.019}, "1760,2140": {-1.000, 0.019}, "1760,2240": {-1.000, 0.019}, "1760,2340": {-1.000, 0.019}, "1760,2440": {-1.000, 0.019}, "1760,2540": {-1.000, 0.019}, "1760,2640": {-1.000, 0.019}, "1760,2740": {-1.000, 0.019}, "1760,2840": {-1.000, 0.019}, "1760,2940": {-1.000, 0.000}, "1760,3040": {-1.000, 0.000}, "1760,3140": {-1.000, 0.000}, "1760,3240": {-1.000, 0.000}, "1760,3340": {-1.000, 0.000}, "1860,-1860": {-1.000, -0.000}, "1860,-1760": {-1.000, -0.000}, "1860,-1660": {-1.000, -0.000}, "1860,-1560": {-1.000, -0.000}, "1860,-1460": {-1.000, -0.000}, "1860,-1360": {-1.000, -0.000}, "1860,-1260": {-1.000, -0.000}, "1860,-1160": {-1.000, -0.000}, "1860,-1060": {-1.000, -0.000}, "1860,-960": {-1.000, -0.000}, "1860,-860": {-1.000, -0.000}, "1860,-760": {-1.000, -0.000}, "1860,-660": {-1.000, -0.000}, "1860,-560": {-1.000, -0.000}, "1860,-460": {-1.000, -0.000}, "1860,-360": {-1.000, -0.000}, "1860,-260": {-1.000, -0.027}, "1860,1240": {-0.983, 0.183}, "1860,1340": {-0.983, 0.183}, "1860,1440": {-0.983, 0.183}, "1860,1540": {-0.988, 0.152}, "1860,1640": {-0.988, 0.152}, "1860,1740": {-0.988, 0.152}, "1860,1840": {-0.988, 0.152}, "1860,1940": {-0.988, 0.152}, "1860,2040": {-1.000, 0.019}, "1860,2140": {-1.000, 0.019}, "1860,2240": {-1.000, 0.019}, "1860,2340": {-1.000, 0.019}, "1860,2440": {-1.000, 0.019}, "1860,2540": {-1.000, 0.019}, "1860,2640": {-1.000, 0.019}, "1860,2740": {-1.000, 0.019}, "1860,2840": {-1.000, 0.019}, "1860,2940": {-1.000, 0.000}, "1860,3040": {-1.000, 0.000}, "1860,3140": {-1.000, 0.000}, "1860,3240": {-1.000, 0.000}, "1860,3340": {-1.000, 0.000}, "1960,-1860": {-1.000, -0.000}, "1960,-1760": {-1.000, -0.000}, "1960,-1660": {-1.000, -0.000}, "1960,-1560": {-1.000, -0.000}, "1960,-1460": {-1.000, -0.000}, "1960,-1360": {-1.000, -0.000}, "1960,-1260": {-1.000, -0.000}, "1960,-1160": {-1.000, -0.000}, "1960,-1060": {-1.000, -0.000}, "1960,-960": {-1.000, -0.000}, "1960,-860": {-1.000, -0.000}, "1960,-760": {-1.000, -0.000}, "1960,-660": {-1.000, -0.000}, "1960,-560": {-1.000, -0.000}, "1960,-460": {-1.000, -0.000}, "1960,-360": {-1.000, -0.000}, "1960,-260": {-1.000, -0.027}, "1960,1240": {-0.887, 0.462}, "1960,1340": {-0.983, 0.183}, "1960,1440": {-0.988, 0.152}, "1960,1540": {-0.988, 0.152}, "1960,1640": {-0.988, 0.152}, "1960,1740": {-0.988, 0.152}, "1960,1840": {-0.988, 0.152}, "1960,1940": {-0.988, 0.152}, "1960,2040": {-0.999, 0.046}, "1960,2140": {-0.999, 0.046}, "1960,2240": {-0.999, 0.046}, "1960,2340": {-0.999, 0.046}, "1960,2440": {-0.999, 0.046}, "1960,2540": {-0.999, 0.046}, "1960,2640": {-0.999, 0.046}, "1960,2740": {-0.999, 0.046}, "1960,2840": {-0.999, 0.046}, "1960,2940": {-1.000, 0.000}, "1960,3040": {-1.000, 0.000}, "1960,3140": {-1.000, 0.000}, "1960,3240": {-1.000, 0.000}, "1960,3340": {-1.000, 0.000}, "2060,-1860": {-1.000, -0.000}, "2060,-1760": {-1.000, -0.000}, "2060,-1660": {-1.000, -0.000}, "2060,-1560": {-1.000, -0.000}, "2060,-1460": {-1.000, -0.000}, "2060,-1360": {-1.000, -0.000}, "2060,-1260": {-1.000, -0.000}, "2060,-1160": {-1.000, -0.000}, "2060,-1060": {-1.000, -0.000}, "2060,-960": {-1.000, -0.000}, "2060,-860": {-1.000, -0.000}, "2060,-760": {-1.000, -0.000}, "2060,-660": {-1.000, -0.000}, "2060,-560": {-1.000, -0.026}, "2060,-460": {-1.000, -0.026}, "2060,-360": {-1.000, -0.026}, "2060,-260": {-0.966, -0.259}, "2060,1140": {-0.887, 0.462}, "2060,1240": {-0.887, 0.462}, "2060,1340": {-0.887, 0.462}, "2060,1440": {-0.949, 0.316}, "2060,1540": {-0.949, 0.316}, "2060,1640": {-0.949, 0.316}, "2060,1740": {-0.988, 0.152}, "2060,1840": {-0.988, 0.152}, "2060,1940": {-0.988, 0.152}, "2060,2040": {-0.999, 0.046}, "2060,2140": {-0.999, 0.046}, "2060,2240": {-0.999, 0.046}, "2060,2340": {-0.999, 0.046}, "2060,2440": {-0.999, 0.046}, "2060,2540": {-0.999, 0.046}, "2060,2640": {-0.999, 0.046}, "2060,2740": {-0.999, 0.046}, "2060,2840": {-0.999, 0.046}, "2060,2940": {-1.000, 0.000}, "2060,3040": {-1.000, 0.000}, "2060,3140": {-1.000, 0.000}, "2060,3240": {-1.000, 0.000}, "2060,3340": {-1.000, 0.000}, "2160,-1860": {-1.000, -0.000}, "2160,-1760": {-1.000, -0.000}, "2160,-1660": {-1.000, -0.000}, "2160,-1560": {-1.000, -0.000}, "2160,-1460": {-1.000, -0.000}, "2160,-1360": {-1.000, -0.000}, "2160,-1260": {-1.000, -0.000}, "2160,-1160": {-1.000, -0.000}, "2160,-1060": {-1.000, -0.000}, "2160,-960": {-1.000, -0.000}, "2160,-860": {-0.999, -0.036}, "2160,-760": {-0.999, -0.036}, "2160,-660": {-0.999, -0.036}, "2160,-560": {-1.000, -0.026}, "2160,-460": {-1.000, -0.026}, "2160,-360": {-1.000, -0.026}, "2160,-260": {-0.966, -0.259}, "2160,1140": {-0.887, 0.462}, "2160,1240": {-0.887, 0.462}, "2160,1340": {-0.887, 0.462}, "2160,1440": {-0.949, 0.316}, "2160,1540": {-0.949, 0.316}, "2160,1640": {-0.949, 0.316}, "2160,1740": {-0.949, 0.316}, "2160,1840": {-0.949, 0.316}, "2160,1940": {-0.949, 0.316}, "2160,2040": {-0.999, 0.046}, "2160,2140": {-0.999, 0.046}, "2160,2240": {-0.999, 0.046}, "2160,2340": {-0.999, 0.046}, "2160,2440": {-0.999, 0.046}, "2160,2540": {-0.999, 0.046}, "2160,2640": {-0.999, 0.046}, "2160,2740": {-0.999, 0.046}, "2160,2840": {-0.999, 0.046}, "2160,2940": {-1.000, 0.026}, "2160,3040": {-1.000, 0.026}, "2160,3140": {-1.000, 0.026}, "2160,3240": {-1.000, 0.026}, "2160,3340": {-1.000, 0.026}, "2260,-1860": {-1.000, -0.000}, "2260,-1760": {-1.000, -0.000}, "2260,-1660": {-1.000, -0.000}, "2260,-1560": {-1.000, -0.017}, "2260,-1460": {-1.000, -0.017}, "2260,-1360": {-1.000, -0.017}, "2260,-1260": {-1.000, -0.017}, "2260,-1160": {-1.000, -0.017}, "2260,-1060": {-1.000, -0.017}, "2260,-960": {-1.000, -0.017}, "2260,-860": {-0.999, -0.036}, "2260,-760": {-0.999, -0.036}, "2260,-660": {-0.999, -0.036}, "2260,-560": {-0.984, -0.179}, "2260,-460": {-0.984, -0.179}, "2260,-360": {-0.984, -0.179}, "2260,-260": {-0.984, -0.179}, "2260,1040": {-0.739, 0.674}, "2260,1140": {-0.739, 0.674}, "2260,1240": {-0.887, 0.462}, "2260,1340": {-0.853, 0.522}, "2260,1440": {-0.949, 0.316}, "2260,1540": {-0.949, 0.316}, "2260,1640": {-0.949, 0.316}, "2260,1740": {-0.949, 0.316}, "2260,1840": {-0.949, 0.316}, "2260,1940": {-0.949, 0.316}, "2260,2040": {-0.987, 0.158}, "2260,2140": {-0.987, 0.158}, "2260,2240": {-0.987, 0.158}, "2260,2340": {-0.987, 0.158}, "2260,2440": {-0.987, 0.158}, "2260,2540": {-0.987, 0.158}, "2260,2640": {-0.987, 0.158}, "2260,2740": {-0.987, 0.158}, "2260,2840": {-0.987, 0.158}, "2260,2940": {-1.000, 0.026}, "2260,3040": {-1.000, 0.026}, "2260,3140": {-1.000, 0.026}, "2260,3240": {-1.000, 0.026}, "2260,3340": {-1.000, 0.026}, "2360,-1860": {-1.000, -0.000}, "2360,-1760": {-1.000, -0.000}, "2360,-1660": {-1.000, -0.000}, "2360,-1560": {-1.000, -0.017}, "2360,-1460": {-1.000, -0.017}, "2360,-1360": {-1.000, -0.017}, "2360,-1260": {-1.000, -0.017}, "2360,-1160": {-1.000, -0.017}, "2360,-1060": {-1.000, -0.017}, "2360,-960": {-1.000, -0.017}, "2360,-860": {-0.999, -0.036}, "2360,-760": {-0.976, -0.217}, "2360,-660": {-0.976, -0.217}, "2360,-560": {-0.976, -0.217}, "2360,-460": {-0.910, -0.414}, "2360,-360": {-0.910, -0.414}, "2360,-260": {-0.910, -0.414}, "2360,-160": {-0.645, -0.764}, "2360,940": {-0.739, 0.674}, "2360,1040": {-0.739, 0.674}, "2360,1140": {-0.739, 0.674}, "2360,1240": {-0.853, 0.522}, "2360,1340": {-0.853, 0.522}, "2360,1440": {-0.853, 0.522}, "2360,1540": {-0.853, 0.522}, "2360,1640": {-0.949, 0.316}, "2360,1740": {-0.949, 0.316}, "2360,1840": {-0.949, 0.316}, "2360,1940": {-0.949, 0.316}, "2360,2040": {-0.987, 0.158}, "2360,2140": {-0.987, 0.158}, "2360,2240": {-0.987, 0.158}, "2360,2340": {-0.987, 0.158}, "2360,2440": {-0.987, 0.158}, "2360,2540": {-0.987, 0.158}, "2360,2640": {-0.987, 0.158}, "2360,2740": {-0.987, 0.158}, "2360,2840": {-0.987, 0.158}, "2360,2940": {-1.000, 0.026}, "2360,3040": {-1.000, 0.026}, "2360,3140": {-1.000, 0.026}, "2360,3240": {-1.000, 0.026}, "2360,3340": {-1.000, 0.026}, "2460,-1860": {-1.000, -0.000}, "2460,-1760": {-1.000, -0.000}, "2460,-1660": {-1.000, -0.000}, "2460,-1560": {-1.000, -0.017}, "2460,-1460": {-1.000, -0.017}, "2460,-1360": {-1.000, -0.017}, "2460,-1260": {-1.000, -0.017}, "2460,-1160": {-1.000, -0.017}, "2460,-1060": {-1.000, -0.017}, "2460,-960": {-0.990, -0.141}, "2460,-860": {-0.976, -0.217}, "2460,-760": {-0.976, -0.217}, "2460,-660": {-0.976, -0.217}, "2460,-560": {-0.976, -0.217}, "2460,-460": {-0.910, -0.414}, "2460,-360": {-0.910, -0.414}, "2460,-260": {-0.707, -0.707}, "2460,-160": {-0.707, -0.707}, "2460,-60": {-0.645, -0.764}, "2460,40": {-0.225, -0.974}, "2460,740": {-0.412, 0.911}, "2460,840": {-0.412, 0.911}, "2460,940": {-0.739, 0.674}, "2460,1040": {-0.739, 0.674}, "2460,1140": {-0.739, 0.674}, "2460,1240": {-0.707, 0.707}, "2460,1340": {-0.853, 0.522}, "2460,1440": {-0.853, 0.522}, "2460,1540": {-0.853, 0.522}, "2460,1640": {-0.853, 0.522}, "2460,1740": {-0.853, 0.522}, "2460,1840": {-0.853, 0.522}, "2460,1940": {-0.987, 0.158}, "2460,2040": {-0.987, 0.158}, "2460,2140": {-0.987, 0.158}, "2460,2240": {-0.987, 0.158}, "2460,2340": {-0.987, 0.158}, "2460,2440": {-0.987, 0.158}, "2460,2540": {-0.987, 0.158}, "2460,2640": {-0.987, 0.158}, "2460,2740": {-0.987, 0.158}, "2460,2840": {-1.000, 0.026}, "2460,2940": {-1.000, 0.026}, "2460,3040": {-1.000, 0.026}, "2460,3140": {-1.000, 0.026}, "2460,3240": {-1.000, 0.026}, "2460,3340": {-1.000, 0.026}, "2560,-1860": {-1.000, -0.015}, "2560,-1760": {-1.000, -0.015}, "2560,-1660": {-1.000, -0.015}, "2560,-1560": {-0.990, -0.141}, "2560,-1460": {-0.990, -0.141}, "2560,-1360": {-0.990, -0.141}, "2560,-1260": {-0.990, -0.141}, "2560,-1160": {-0.990, -0.141}, "2560,-1060": {-0.990, -0.141}, "2560,-960": {-0.990, -0.141}, "2560,-860": {-0.976, -0.217}, "2560,-760": {-0.976, -0.217}, "2560,-660": {-0.889, -0.458}, "2560,-560": {-0.889, -0.458}, "2560,-460": {-0.910, -0.414}, "2560,-360": {-0.707, -0.707}, "2560,-260": {-0.707, -0.707}, "2560,-160": {-0.347, -0.938}, "2560,-60": {-0.347, -0.938}, "2560,40": {-0.093, -0.996}, "2560,140": {0.000, -1.000}, "2560,240": {0.000, -1.000}, "2560,340": {0.000, -1.000}, "2560,440": {-0.038, 0.999}, "2560,540": {-0.107, 0.994}, "2560,640": {-0.107, 0.994}, "2560,740": {-0.412, 0.911}, "2560,840": {-0.412, 0.911}, "2560,940": {-0.412, 0.911}, "2560,1040": {-0.739, 0.674}, "2560,1140": {-0.707, 0.707}, "2560,1240": {-0.707, 0.707}, "2560,1340": {-0.707, 0.707}, "2560,1440": {-0.853, 0.522}, "2560,1540": {-0.853, 0.522}, "2560,1640": {-0.853, 0.522}, "2560,1740": {-0.853, 0.522}, "2560,1840": {-0.853, 0.522}, "2560,1940": {-0.897, 0.442}, "2560,2040": {-0.987, 0.158}, "2560,2140": {-0.987, 0.158}, "2560,2240": {-0.987, 0.158}, "2560,2340": {-0.987, 0.158}, "2560,2440": {-0.987, 0.158}, "2560,2540": {-0.987, 0.158}, "2560,2640": {-0.987, 0.158}, "2560,2740": {-0.987, 0.158}, "2560,2840": {-1.000, 0.026}, "2560,2940": {-1.000, 0.026}, "2560,3040": {-1.000, 0.026}, "2560,3140": {-1.000, 0.026}, "2560,3240": {-1.000, 0.026}, "2560,3340": {-1.000, 0.026}, "2660,-1860": {-1.000, -0.015}, "2660,-1760": {-1.000, -0.015}, "2660,-1660": {-1.000, -0.015}, "2660,-1560": {-0.990, -0.141}, "2660,-1460": {-0.990, -0.141}, "2660,-1360": {-0.990, -0.141}, "2660,-1260": {-0.990, -0.141}, "2660,-1160": {-0.990, -0.141}, "2660,-1060": {-0.990, -0.141}, "2660,-960": {-0.990, -0.141}, "2660,-860": {-0.889, -0.458}, "2660,-760": {-0.889, -0.458}, "2660,-660": {-0.889, -0.458}, "2660,-560": {-0.889, -0.458}, "2660,-460": {-0.759, -0.651}, "2660,-360": {-0.707, -0.707}, "2660,-260": {-0.707, -0.707}, "2660,-160": {-0.347, -0.938}, "2660,-60": {-0.093, -0.996}, "2660,40": {-0.093, -0.996}, "2660,140": {0.000, -1.000}, "2660,240": {0.000, -1.000}, "2660,340": {0.000, -1.000}, "2660,440": {0.000, -1.000}, "2660,540": {0.000, -1.000}, "2660,640": {-0.107, 0.994}, "2660,740": {-0.412, 0.911}, "2660,840": {-0.412, 0.911}, "2660,940": {-0.506, 0.862}, "2660,1040": {-0.506, 0.862}, "2660,1140": {-0.506, 0.862}, "2660,1240": {-0.707, 0.707}, "2660,1340": {-0.707, 0.707}, "2660,1440": {-0.707, 0.707}, "2660,1540": {-0.707, 0.707}, "2660,1640": {-0.853, 0.522}, "2660,1740": {-0.853, 0.522}, "2660,1840": {-0.853, 0.522}, "2660,1940": {-0.897, 0.442}, "2660,2040": {-0.897, 0.442}, "2660,2140": {-0.897, 0.442}, "2660,2240": {-0.897, 0.442}, "2660,2340": {-0.897, 0.442}, "2660,2440": {-0.987, 0.158}, "2660,2540": {-0.987, 0.158}, "2660,2640": {-0.987, 0.158}, "2660,2740": {-0.987, 0.158}, "2660,2840": {-0.976, 0.220}, "2660,2940": {-0.976, 0.220}, "2660,3040": {-1.000, 0.026}, "2660,3140": {-1.000, 0.026}, "2660,3240": {-1.000, 0.026}, "2660,3340": {-1.000, 0.026}, "2760,-1860": {-1.000, -0.015}, "2760,-1760": {-1.000, -0.015}, "2760,-1660": {-1.000, -0.015}, "2760,-1560": {-1.000, -0.015}, "2760,-1460": {-0.990, -0.141}, "2760,-1360": {-0.990, -0.141}, "2760,-1260": {-0.990, -0.141}, "2760,-1160": {-0.930, -0.369}, "2760,-1060": {-0.930, -0.369}, "2760,-960": {-0.930, -0.369}, "2760,-860": {-0.930, -0.369}, "2760,-760": {-0.889, -0.458}, "2760,-660": {-0.759, -0.651}, "2760,-560": {-0.759, -0.651}, "2760,-460": {-0.759, -0.651}, "2760,-360": {-0.575, -0.818}, "2760,-260": {-0.347, -0.938}, "2760,-160": {-0.347, -0.938}, "2760,-60": {-0.093, -0.996}, "2760,40": {-0.093, -0.996}, "2760,140": {0.000, -1.000}, "2760,240": {0.000, -1.000}, "2760,340": {0.000, -1.000}, "2760,440": {0.000, -1.000}, "2760,540": {0.000, -1.000}, "2760,640": {0.000, 1.000}, "2760,740": {0.000, 1.000}, "2760,840": {-0.215, 0.977}, "2760,940": {-0.215, 0.977}, "2760,1040": {-0.506, 0.862}, "2760,1140": {-0.506, 0.862}, "2760,1240": {-0.707, 0.707}, "2760,1340": {-0.707, 0.707}, "2760,1440": {-0.707, 0.707}, "2760,1540": {-0.707, 0.707}, "2760,1640": {-0.707, 0.707}, "2760,1740": {-0.853, 0.522}, "2760,1840": {-0.897, 0.442}, "2760,1940": {-0.897, 0.442}, "2760,2040": {-0.897, 0.442}, "2760,2140": {-0.897, 0.442}, "2760,2240": {-0.897, 0.442}, "2760,2340": {-0.897, 0.442}, "2760,2440": {-0.897, 0.442}, "2760,2540": {-0.897, 0.442}, "2760,2640": {-0.897, 0.442}, "2760,2740": {-0.897, 0.442}, "2760,2840": {-0.976, 0.220}, "2760,2940": {-0.976, 0.220}, "2760,3040": {-0.976, 0.220}, "2760,3140": {-0.976, 0.220}, "2760,3240": {-0.976, 0.220}, "2760,3340": {-0.976, 0.220}, "2860,-1860": {-0.998, -0.058}, "2860,-1760": {-0.998, -0.058}, "2860,-1660": {-0.998, -0.058}, "2860,-1560": {-0.998, -0.058}, "2860,-1460": {-0.930, -0.369}, "2860,-1360": {-0.930, -0.369}, "2860,-1260": {-0.930, -0.369}, "2860,-1160": {-0.930, -0.369}, "2860,-1060": {-0.930, -0.369}, "2860,-960": {-0.930, -0.369}, "2860,-860": {-0.930, -0.369}, "2860,-760": {-0.759, -0.651}, "2860,-660": {-0.759, -0.651}, "2860,-560": {-0.759, -0.651}, "2860,-460": {-0.575, -0.818}, "2860,-360": {-0.575, -0.818}, "2860,-260": {-0.298, -0.954}, "2860,-160": {-0.298, -0.954}, "2860,-60": {-0.091, -0.996}, "2860,40": {0.000, -1.000}, "2860,140": {0.000, -1.000}, "2860,240": {0.000, -1.000}, "2860,340": {-0.000, -1.000}, "2860,440": {0.040, 0.999}, "2860,540": {0.040, 0.999}, "2860,640": {0.000, 1.000}, "2860,740": {0.000, 1.000}, "2860,840": {-0.215, 0.977}, "2860,940": {-0.215, 0.977}, "2860,1040": {-0.506, 0.862}, "2860,1140": {-0.506, 0.862}, "2860,1240": {-0.506, 0.862}, "2860,1340": {-0.707, 0.707}, "2860,1440": {-0.707, 0.707}, "2860,1540": {-0.707, 0.707}, "2860,1640": {-0.707, 0.707}, "2860,1740": {-0.699, 0.715}, "2860,1840": {-0.699, 0.715}, "2860,1940": {-0.897, 0.442}, "2860,2040": {-0.897, 0.442}, "2860,2140": {-0.897, 0.442}, "2860,2240": {-0.897, 0.442}, "2860,2340": {-0.897, 0.442}, "2860,2440": {-0.897, 0.442}, "2860,2540": {-0.897, 0.442}, "2860,2640": {-0.897, 0.442}, "2860,2740": {-0.897, 0.442}, "2860,2840": {-0.860, 0.511}, "2860,2940": {-0.976, 0.220}, "2860,3040": {-0.976, 0.220}, "2860,3140": {-0.976, 0.220}, "2860,3240": {-0.976, 0.220}, "2860,3340": {-0.976, 0.220}, "2960,-1860": {-0.998, -0.058}, "2960,-1760": {-0.998, -0.058}, "2960,-1660": {-0.998, -0.058}, "2960,-1560": {-0.998, -0.058}, "2960,-1460": {-0.930, -0.369}, "2960,-1360": {-0.930, -0.369}, "2960,-1260": {-0.930, -0.369}, "2960,-1160": {-0.930, -0.369}, "2960,-1060": {-0.930, -0.369}, "2960,-960": {-0.930, -0.369}, "2960,-860": {-0.930, -0.369}, "2960,-760": {-0.796, -0.606}, "2960,-660": {-0.759, -0.651}, "2960,-560": {-0.575, -0.818}, "2960,-460": {-0.575, -0.818}, "2960,-360": {-0.575, -0.818}, "2960,-260": {-0.298, -0.954}, "2960,-160": {-0.298, -0.954}, "2960,-60": {-0.091, -0.996}, "2960,40": {0.000, -1.000}, "2960,140": {0.000, -1.000}, "2960,240": {-0.000, -1.000}, "2960,340": {-0.000, -1.000}, "2960,440": {0.040, 0.999}, "2960,540": {0.000, 1.000}, "2960,640": {0.000, 1.000}, "2960,740": {0.000, 1.000}, "2960,840": {-0.215, 0.977}, "2960,940": {-0.215, 0.977}, "2960,1040": {-0.215, 0.977}, "2960,1140": {-0.506, 0.862}, "2960,1240": {-0.506, 0.862}, "2960,1340": {-0.506, 0.862}, "2960,1440": {-0.707, 0.707}, "2960,1540": {-0.707, 0.707}, "2960,1640": {-0.699, 0.715}, "2960,1740": {-0.699, 0.715}, "2960,1840": {-0.699, 0.715}, "2960,1940": {-0.699, 0.715}, "2960,2040": {-0.897, 0.442}, "2960,2140": {-0.897, 0.442}, "2960,2240": {-0.897, 0.442}, "2960,2340": {-0.897, 0.442}, "2960,2440": {-0.897, 0.442}, "2960,2540": {-0.897, 0.442}, "2960,2640": {-0.897, 0.442}, "2960,2740": {-0.860, 0.511}, "2960,2840": {-0.860, 0.511}, "2960,2940": {-0.860, 0.511}, "2960,3040": {-0.860, 0.511}, "2960,3140": {-0.976, 0.220}, "2960,3240": {-0.976, 0.220}, "2960,3340": {-0.976, 0.220}, "3060,-1860": {-0.998, -0.058}, "3060,-1760": {-0.998, -0.058}, "3060,-1660": {-0.998, -0.058}, "3060,-1560": {-0.998, -0.058}, "3060,-1460": {-0.930, -0.369}, "3060,-1360": {-0.930, -0.369}, "3060,-1260": {-0.930, -0.369}, "3060,-1160": {-0.930, -0.369}, "3060,-1060": {-0.930, -0.369}, "3060,-960": {-0.796, -0.606}, "3060,-860": {-0.796, -0.606}, "3060,-760": {-0.796, -0.606}, "3060,-660": {-0.796, -0.606}, "3060,-560": {-0.575, -0.818}, "3060,-460": {-0.575, -0.818}, "3060,-360": {-0.298, -0.954}, "3060,-260": {-0.298, -0.954}, "3060,-160": {-0.091, -0.996}, "3060,-60": {-0.091, -0.996}, "3060,40": {0.000, -1.000}, "3060,140": {0.000, -1.000}, "3060,240": {-0.000, -1.000}, "3060,340": {-0.000, -1.000}, "3060,440": {0.040, 0.999}, "3060,540": {0.000, 1.000}, "3060,640": {0.000, 1.000}, "3060,740": {0.000, 1.000}, "3060,840": {-0.215, 0.977}, "3060,940": {-0.215, 0.977}, "3060,1040": {-0.215, 0.977}, "3060,1140": {-0.506, 0.862}, "3060,1240": {-0.506, 0.862}, "3060,1340": {-0.506, 0.862}, "3060,1440": {-0.586, 0.810}, "3060,1540": {-0.586, 0.810}, "3060,1640": {-0.586, 0.810}, "3060,1740": {-0.699, 0.715}, "3060,1840": {-0.699, 0.715}, "3060,1940": {-0.699, 0.715}, "3060,2040": {-0.699, 0.715}, "3060,2140": {-0.699, 0.715}, "3060,2240": {-0.897, 0.442}, "3060,2340": {-0.897, 0.442}, "3060,2440": {-0.897, 0.442}, "3060,2540": {-0.897, 0.442}, "3060,2640": {-0.897, 0.442}, "3060,2740": {-0.756, 0.655}, "3060,2840": {-0.860, 0.511}, "3060,2940": {-0.860, 0.511}, "3060,3040": {-0.860, 0.511}, "3060,3140": {-0.860, 0.511}, "3060,3240": {-0.860, 0.511}, "3060,3340": {-0.860, 0.511}, "3160,-1860": {-0.998, -0.058}, "3160,-1760": {-0.998, -0.058}, "3160,-1660": {-0.998, -0.058}, "3160,-1560": {-0.998, -0.058}, "3160,-1460": {-0.930, -0.369}, "3160,-1360": {-0.930, -0.369}, "3160,-1260": {-0.930, -0.369}, "3160,-1160": {-0.796, -0.606}, "3160,-1060": {-0.796, -0.606}, "3160,-960": {-0.796, -0.606}, "3160,-860": {-0.796, -0.606}, "3160,-760": {-0.796, -0.606}, "3160,-660": {-0.633, -0.774}, "3160,-560": {-0.633, -0.774}, "3160,-460": {-0.575, -0.818}, "3160,-360": {-0.298, -0.954}, "3160,-260": {-0.298, -0.954}, "3160,-160": {-0.091, -0.996}, "3160,-60": {-0.091, -0.996}, "3160,40": {0.000, -1.000}, "3160,140": {0.000, -1.000}, "3160,240": {-0.000, -1.000}, "3160,340": {-0.000, -1.000}, "3160,440": {0.040, 0.999}, "3160,540": {0.000, 1.000}, "3160,640": {0.000, 1.000}, "3160,740": {0.000, 1.000}, "3160,840": {-0.215, 0.977}, "3160,940": {-0.215, 0.977}, "3160,1040": {-0.215, 0.977}, "3160,1140": {-0.506, 0.862}, "3160,1240": {-0.506, 0.862}, "3160,1340": {-0.475, 0.880}, "3160,1440": {-0.586, 0.810}, "3160,1540": {-0.586, 0.810}, "3160,1640": {-0.586, 0.810}, "3160,1740": {-0.586, 0.810}, "3160,1840": {-0.699, 0.715}, "3160,1940": {-0.699, 0.715}, "3160,2040": {-0.699, 0.715}, "3160,2140": {-0.699, 0.715}, "3160,2240": {-0.699, 0.715}, "3160,2340": {-0.897, 0.442}, "3160,2440": {-0.897, 0.442}, "3160,2540": {-0.897, 0.442}, "3160,2640": {-0.756, 0.655}, "3160,2740": {-0.756, 0.655}, "3160,2840": {-0.756, 0.655}, "3160,2940": {-0.860, 0.511}, "3160,3040": {-0.860, 0.511}, "3160,3140": {-0.860, 0.511}, "3160,3240": {-0.860, 0.511}, "3260,-1860": {-0.985, -0.170}, "3260,-1760": {-0.985, -0.170}, "3260,-1660": {-0.985, -0.170}, "3260,-1560": {-0.985, -0.170}, "3260,-1460": {-0.985, -0.170}, "3260,-1360": {-0.930, -0.369}, "3260,-1260": {-0.796, -0.606}, "3260,-1160": {-0.796, -0.606}, "3260,-1060": {-0.796, -0.606}, "3260,-960": {-0.796, -0.606}, "3260,-860": {-0.796, -0.606}, "3260,-760": {-0.633, -0.774}, "3260,-660": {-0.633, -0.774}, "3260,-560": {-0.633, -0.774}, "3260,-460": {-0.633, -0.774}, "3260,-360": {-0.388, -0.922}, "3260,-260": {-0.298, -0.954}, "3260,-160": {-0.091, -0.996}, "3260,-60": {-0.091, -0.996}, "3260,40": {0.000, -1.000}, "3260,140": {0.000, -1.000}, "3260,240": {-0.000, -1.000}, "3260,340": {-0.000, -1.000}, "3260,440": {0.040, 0.999}, "3260,540": {0.000, 1.000}, "3260,640": {0.000, 1.000}, "3260,740": {0.000, 1.000}, "3260,840": {-0.215, 0.977}, "3260,940": {-0.215, 0.977}, "3260,1040": {-0.215, 0.977}, "3260,1140": {-0.339, 0.941}, "3260,1240": {-0.475, 0.880}, "3260,1340": {-0.475, 0.880}, "3260,1440": {-0.475, 0.880}, "3260,1540": {-0.586, 0.810}, "3260,1640": {-0.586, 0.810}, "3260,1740": {-0.586, 0.810}, "3260,1840": {-0.586, 0.810}, "3260,1940": {-0.699, 0.715}, "3260,2040": {-0.699, 0.715}, "3260,2140": {-0.699, 0.715}, "3260,2240": {-0.699, 0.715}, "3260,2340": {-0.699, 0.715}, "3260,2440": {-0.897, 0.442}, "3260,2540": {-0.756, 0.655}, "3260,2640": {-0.756, 0.655}, "3260,2740": {-0.756, 0.655}, "3260,2840": {-0.756, 0.655}, "3260,2940": {-0.756, 0.655}, "3260,3040": {-0.860, 0.511}, "3260,3140": {-0.860, 0.511}, "3360,-1860": {-0.985, -0.170}, "3360,-1760": {-0.985, -0.170}, "3360,-1660": {-0.985, -0.170}, "3360,-1560": {-0.985, -0.170}, "3360,-1460": {-0.985, -0.170}, "3360,-1360": {-0.796, -0.606}, "3360,-1260": {-0.796, -0.606}, "3360,-1160": {-0.796, -0.606}, "3360,-1060": {-0.796, -0.606}, "3360,-960": {-0.796, -0.606}, "3360,-860": {-0.633, -0.774}, "3360,-760": {-0.633, -0.774}, "3360,-660": {-0.633, -0.774}, "3360,-560": {-0.633, -0.774}, "3360,-460": {-0.388, -0.922}, "3360,-360": {-0.388, -0.922}, "3360,-260": {-0.388, -0.922}, "3360,-160": {-0.128, -0.992}, "3360,-60": {-0.128, -0.992}, "3360,40": {-0.017, -1.000}, "3360,140": {-0.017, -1.000}, "3360,240": {-0.017, -1.000}, "3360,340": {0.000, 1.000}, "3360,440": {0.000, 1.000}, "3360,540": {0.000, 1.000}, "3360,640": {-0.021, 1.000}, "3360,740": {-0.021, 1.000}, "3360,840": {-0.139, 0.990}, "3360,940": {-0.139, 0.990}, "3360,1040": {-0.339, 0.941}, "3360,1140": {-0.339, 0.941}, "3360,1240": {-0.339, 0.941}, "3360,1340": {-0.475, 0.880}, "3360,1440": {-0.475, 0.880}, "3360,1540": {-0.475, 0.880}, "3360,1640": {-0.586, 0.810}, "3360,1740": {-0.586, 0.810}, "3360,1840": {-0.586, 0.810}, "3360,1940": {-0.699, 0.715}, "3360,2040": {-0.699, 0.715}, "3360,2140": {-0.699, 0.715}, "3360,2240": {-0.699, 0.715}, "3360,2340": {-0.699, 0.715}, "3360,2440": {-0.656, 0.755}, "3360,2540": {-0.656, 0.755}, "3360,2640": {-0.756, 0.655}, "3360,2740": {-0.756, 0.655}, "3360,2840": {-0.756, 0.655}, "3360,2940": {-0.756, 0.655}, "3360,3040": {-0.756, 0.655}, "3460,-1860": {-0.985, -0.170}, "3460,-1760": {-0.985, -0.170}, "3460,-1660": {-0.985, -0.170}, "3460,-1560": {-0.985, -0.170}, "3460,-1460": {-0.985, -0.170}, "3460,-1360": {-0.796, -0.606}, "3460,-1260": {-0.796, -0.606}, "3460,-1160": {-0.796, -0.606}, "3460,-1060": {-0.796, -0.606}, "3460,-960": {-0.633, -0.774}, "3460,-860": {-0.633, -0.774}, "3460,-760": {-0.633, -0.774}, "3460,-660": {-0.633, -0.774}, "3460,-560": {-0.388, -0.922}, "3460,-460": {-0.388, -0.922}, "3460,-360": {-0.388, -0.922}, "3460,-260": {-0.128, -0.992}, "3460,-160": {-0.128, -0.992}, "3460,-60": {-0.128, -0.992}, "3460,40": {-0.017, -1.000}, "3460,140": {-0.017, -1.000}, "3460,240": {-0.017, -1.000}, "3460,340": {0.000, 1.000}, "3460,440": {0.000, 1.000}, "3460,540": {0.000, 1.000}, "3460,640": {-0.021, 1.000}, "3460,740": {-0.021, 1.000}, "3460,840": {-0.139, 0.990}, "3460,940": {-0.139, 0.990}, "3460,1040": {-0.339, 0.941}, "3460,1140": {-0.339, 0.941}, "3460,1240": {-0.339, 0.941}, "3460,1340": {-0.475, 0.880}, "3460,1440": {-0.475, 0.880}, "3460,1540": {-0.475, 0.880}, "3460,1640": {-0.586, 0.810}, "3460,1740": {-0.586, 0.810}, "3460,1840": {-0.586, 0.810}, "3460,1940": {-0.586, 0.810}, "3460,2040": {-0.699, 0.715}, "3460,2140": {-0.699, 0.715}, "3460,2240": {-0.699, 0.715}, "3460,2340": {-0.656, 0.755}, "3460,2440": {-0.656, 0.755}, "3460,2540": {-0.656, 0.755}, "3460,2640": {-0.656, 0.755}, "3460,2740": {-0.756, 0.655}, "3460,2840": {-0.756, 0.655}, "3460,2940": {-0.756, 0.655}, "3560,-1860": {-0.985, -0.170}, "3560,-1760": {-0.910, -0.415}, "3560,-1660": {-0.910, -0.415}, "3560,-1560": {-0.910, -0.415}, "3560,-1460": {-0.910, -0.415}, "3560,-1360": {-0.910, -0.415}, "3560,-1260": {-0.796, -0.606}, "3560,-1160": {-0.796, -0.606}, "3560,-1060": {-0.633, -0.774}, "3560,-960": {-0.633, -0.774}, "3560,-860": {-0.633, -0.774}, "3560,-760": {-0.633, -0.774}, "3560,-660": {-0.388, -0.922}, "3560,-560": {-0.388, -0.922}, "3560,-460": {-0.388, -0.922}, "3560,-360": {-0.388, -0.922}, "3560,-260": {-0.128, -0.992}, "3560,-160": {-0.128, -0.992}, "3560,-60": {-0.128, -0.992}, "3560,40": {-0.017, -1.000}, "3560,140": {-0.017, -1.000}, "3560,240": {-0.017, -1.000}, "3560,340": {0.000, 1.000}, "3560,440": {0.000, 1.000}, "3560,540": {0.000, 1.000}, "3560,640": {-0.021, 1.000}, "3560,740": {-0.021, 1.000}, "3560,840": {-0.139, 0.990}, "3560,940": {-0.139, 0.990}, "3560,1040": {-0.139, 0.990}, "3560,1140": {-0.339, 0.941}, "3560,1240": {-0.339, 0.941}, "3560,1340": {-0.475, 0.880}, "3560,1440": {-0.475, 0.880}, "3560,1540": {-0.475, 0.880}, "3560,1640": {-0.475, 0.880}, "3560,1740": {-0.586, 0.810}, "3560,1840": {-0.586, 0.810}, "3560,1940": {-0.586, 0.810}, "3560,2040": {-0.586, 0.810}, "3560,2140": {-0.699, 0.715}, "3560,2240": {-0.552, 0.834}, "3560,2340": {-0.656, 0.755}, "3560,2440": {-0.656, 0.755}, "3560,2540": {-0.656, 0.755}, "3560,2640": {-0.656, 0.755}, "3560,2740": {-0.656, 0.755}, "3560,2840": {-0.756, 0.655}, "3660,-1860": {-0.910, -0.415}, "3660,-1760": {-0.910, -0.415}, "3660,-1660": {-0.910, -0.415}, "3660,-1560": {-0.910, -0.415}, "3660,-1460": {-0.910, -0.415}, "3660,-1360": {-0.910, -0.415}, "3660,-1260": {-0.737, -0.676}, "3660,-1160": {-0.633, -0.774}, "3660,-1060": {-0.633, -0.774}, "3660,-960": {-0.633, -0.774}, "3660,-860": {-0.633, -0.774}, "3660,-760": {-0.633, -0.774}, "3660,-660": {-0.388, -0.922}, "3660,-560": {-0.388, -0.922}, "3660,-460": {-0.388, -0.922}, "3660,-360": {-0.388, -0.922}, "3660,-260": {-0.128, -0.992}, "3660,-160": {-0.128, -0.992}, "3660,-60": {-0.128, -0.992}, "3660,40": {-0.017, -1.000}, "3660,140": {-0.017, -1.000}, "3660,240": {-0.017, -1.000}, "3660,340": {0.000, 1.000}, "3660,440": {0.000, 1.000}, "3660,540": {0.000, 1.000}, "3660,640": {-0.021, 1.000}, "3660,740": {-0.021, 1.000}, "3660,840": {-0.139, 0.990}, "3660,940": {-0.139, 0.990}, "3660,1040": {-0.139, 0.990}, "3660,1140": {-0.339, 0.941}, "3660,1240": {-0.339, 0.941}, "3660,1340": {-0.339, 0.941}, "3660,1440": {-0.475, 0.880}, "3660,1540": {-0.475, 0.880}, "3660,1640": {-0.475, 0.880}, "3660,1740": {-0.475, 0.880}, "3660,1840": {-0.586, 0.810}, "3660,1940": {-0.586, 0.810}, "3660,2040": {-0.586, 0.810}, "3660,2140": {-0.552, 0.834}, "3660,2240": {-0.552, 0.834}, "3660,2340": {-0.552, 0.834}, "3660,2440": {-0.656, 0.755}, "3660,2540": {-0.656, 0.755}, "3660,2640": {-0.656, 0.755}, "3660,2740": {-0.656, 0.755}, "3660,2840": {-0.656, 0.755}, "3760,-1760": {-0.910, -0.415}, "3760,-1660": {-0.910, -0.415}, "3760,-1560": {-0.910, -0.415}, "3760,-1460": {-0.737, -0.676}, "3760,-1360": {-0.737, -0.676}, "3760,-1260": {-0.737, -0.676}, "3760,-1160": {-0.737, -0.676}, "3760,-1060": {-0.633, -0.774}, "3760,-960": {-0.633, -0.774}, "3760,-860": {-0.633, -0.774}, "3760,-760": {-0.388, -0.922}, "3760,-660": {-0.388, -0.922}, "3760,-560": {-0.388, -0.922}, "3760,-460": {-0.388, -0.922}, "3760,-360": {-0.388, -0.922}, "3760,-260": {-0.128, -0.992}, "3760,-160": {-0.128, -0.992}, "3760,-60": {-0.128, -0.992}, "3760,40": {-0.017, -1.000}, "3760,140": {-0.017, -1.000}, "3760,240": {-0.017, -1.000}, "3760,340": {0.000, 1.000}, "3760,440": {0.000, 1.000}, "3760,540": {0.000, 1.000}, "3760,640": {-0.021, 1.000}, "3760,740": {-0.021, 1.000}, "3760,840": {-0.139, 0.990}, "3760,940": {-0.139, 0.990}, "3760,1040": {-0.139, 0.990}, "3760,1140": {-0.339, 0.941}, "3760,1240": {-0.339, 0.941}, "3760,1340": {-0.339, 0.941}, "3760,1440": {-0.475, 0.880}, "3760,1540": {-0.475, 0.880}, "3760,1640": {-0.475, 0.880}, "3760,1740": {-0.475, 0.880}, "3760,1840": {-0.586, 0.810}, "3760,1940": {-0.464, 0.886}, "3760,2040": {-0.464, 0.886}, "3760,2140": {-0.552, 0.834}, "3760,2240": {-0.552, 0.834}, "3760,2340": {-0.552, 0.834}, "3760,2440": {-0.552, 0.834}, "3760,2540": {-0.656, 0.755}, "3760,2640": {-0.656, 0.755}, "3760,2740": {-0.656, 0.755}, "3860,-1760": {-0.910, -0.415}, "3860,-1660": {-0.910, -0.415}, "3860,-1560": {-0.737, -0.676}, "3860,-1460": {-0.737, -0.676}, "3860,-1360": {-0.737, -0.676}, "3860,-1260": {-0.737, -0.676}, "3860,-1160": {-0.737, -0.676}, "3860,-1060": {-0.540, -0.842}, "3860,-960": {-0.633, -0.774}, "3860,-860": {-0.388, -0.922}, "3860,-760": {-0.388, -0.922}, "3860,-660": {-0.388, -0.922}, "3860,-560": {-0.388, -0.922}, "3860,-460": {-0.388, -0.922}, "3860,-360": {-0.128, -0.992}, "3860,-260": {-0.128, -0.992}, "3860,-160": {-0.128, -0.992}, "3860,-60": {-0.128, -0.992}, "3860,40": {-0.017, -1.000}, "3860,140": {-0.017, -1.000}, "3860,240": {-0.017, -1.000}, "3860,340": {0.000, 1.000}, "3860,440": {0.000, 1.000}, "3860,540": {0.000, 1.000}, "3860,640": {-0.021, 1.000}, "3860,740": {-0.021, 1.000}, "3860,840": {-0.139, 0.990}, "3860,940": {-0.139, 0.990}, "3860,1040": {-0.139, 0.990}, "3860,1140": {-0.339, 0.941}, "3860,1240": {-0.339, 0.941}, "3860,1340": {-0.339, 0.941}, "3860,1440": {-0.339, 0.941}, "3860,1540": {-0.475, 0.880}, "3860,1640": {-0.475, 0.880}, "3860,1740": {-0.464, 0.886}, "3860,1840": {-0.464, 0.886}, "3860,1940": {-0.464, 0.886}, "3860,2040": {-0.464, 0.886}, "3860,2140": {-0.552, 0.834}, "3860,2240": {-0.552, 0.834}, "3860,2340": {-0.552, 0.834}, "3860,2440": {-0.552, 0.834}, "3860,2540": {-0.656, 0.755}, "3860,2640": {-0.656, 0.755}, "3960,-1660": {-0.737, -0.676}, "3960,-1560": {-0.737, -0.676}, "3960,-1460": {-0.737, -0.676}, "3960,-1360": {-0.737, -0.676}, "3960,-1260": {-0.737, -0.676}, "3960,-1160": {-0.540, -0.842}, "3960,-1060": {-0.540, -0.842}, "3960,-960": {-0.540, -0.842}, "3960,-860": {-0.388, -0.922}, "3960,-760": {-0.388, -0.922}, "3960,-660": {-0.388, -0.922}, "3960,-560": {-0.388, -0.922}, "3960,-460": {-0.388, -0.922}, "3960,-360": {-0.128, -0.992}, "3960,-260": {-0.128, -0.992}, "3960,-160": {-0.128, -0.992}, "3960,-60": {-0.128, -0.992}, "3960,40": {-0.017, -1.000}, "3960,140": {-0.017, -1.000}, "3960,240": {-0.017, -1.000}, "3960,340": {0.000, 1.000}, "3960,440": {0.000, 1.000}, "3960,540": {0.000, 1.000}, "3960,640": {-0.021, 1.000}, "3960,740": {-0.021, 1.000}, "3960,840": {-0.139, 0.990}, "3960,940": {-0.139, 0.990}, "3960,1040": {-0.139, 0.990}, "3960,1140": {-0.139, 0.990}, "3960,1240": {-0.339, 0.941}, "3960,1340": {-0.339, 0.941}, "3960,1440": {-0.339, 0.941}, "3960,1540": {-0.403, 0.915}, "3960,1640": {-0.403, 0.915}, "3960,1740": {-0.403, 0.915}, "3960,1840": {-0.464, 0.886}, "3960,1940": {-0.464, 0.886}, "3960,2040": {-0.464, 0.886}, "3960,2140": {-0.464, 0.886}, "3960,2240": {-0.552, 0.834}, "3960,2340": {-0.552, 0.834}, "3960,2440": {-0.552, 0.834}, "3960,2540": {-0.552, 0.834}, "4060,-1660": {-0.737, -0.676}, "4060,-1560": {-0.737, -0.676}, "4060,-1460": {-0.737, -0.676}, "4060,-1360": {-0.737, -0.676}, "4060,-1260": {-0.540, -0.842}, "4060,-1160": {-0.540, -0.842}, "4060,-1060": {-0.540, -0.842}, "4060,-960": {-0.540, -0.842}, "4060,-860": {-0.303, -0.953}, "4060,-760": {-0.303, -0.953}, "4060,-660": {-0.388, -0.922}, "4060,-560": {-0.388, -0.922}, "4060,-460": {-0.388, -0.922}, "4060,-360": {-0.128, -0.992}, "4060,-260": {-0.128, -0.992}, "4060,-160": {-0.128, -0.992}, "4060,-60": {-0.128, -0.992}, "4060,40": {-0.017, -1.000}, "4060,140": {-0.017, -1.000}, "4060,240": {-0.017, -1.000}, "4060,340": {0.000, 1.000}, "4060,440": {0.000, 1.000}, "4060,540": {0.000, 1.000}, "4060,640": {-0.021, 1.000}, "4060,740": {-0.021, 1.000}, "4060,840": {-0.139, 0.990}, "4060,940": {-0.139, 0.990}, "4060,1040": {-0.139, 0.990}, "4060,1140": {-0.139, 0.990}, "4060,1240": {-0.320, 0.947}, "4060,1340": {-0.320, 0.947}, "4060,1440": {-0.403, 0.915}, "4060,1540": {-0.403, 0.915}, "4060,1640": {-0.403, 0.915}, "4060,1740": {-0.403, 0.915}, "4060,1840": {-0.464, 0.886}, "4060,1940": {-0.464, 0.886}, "4060,2040": {-0.464, 0.886}, "4060,2140": {-0.464, 0.886}, "4060,2240": {-0.552, 0.834}, "4060,2340": {-0.552, 0.834}, "4060,2440": {-0.552, 0.834}, "4160,-1660": {-0.737, -0.676}, "4160,-1560": {-0.737, -0.676}, "4160,-1460": {-0.737, -0.676}, "4160,-1360": {-0.540, -0.842}, "4160,-1260": {-0.540, -0.842}, "4160,-1160": {-0.540, -0.842}, "4160,-1060": {-0.540, -0.842}, "4160,-960": {-0.540, -0.842}, "4160,-860": {-0.303, -0.953}, "4160,-760": {-0.303, -0.953}, "4160,-660": {-0.303, -0.953}, "4160,-560": {-0.071, -0.997}, "4160,-460": {-0.071, -0.997}, "4160,-360": {-0.071, -0.997}, "4160,-260": {-0.000, -1.000}, "4160,-160": {-0.000, -1.000}, "4160,-60": {-0.000, -1.000}, "4160,40": {0.000, -1.000}, "4160,140": {0.000, -1.000}, "4160,240": {0.000, -1.000}, "4160,340": {0.000, -1.000}, "4160,440": {0.000, 1.000}, "4160,540": {0.000, 1.000}, "4160,640": {-0.044, 0.999}, "4160,740": {-0.044, 0.999}, "4160,840": {-0.044, 0.999}, "4160,940": {-0.175, 0.985}, "4160,1040": {-0.175, 0.985}, "4160,1140": {-0.320, 0.947}, "4160,1240": {-0.320, 0.947}, "4160,1340": {-0.320, 0.947}, "4160,1440": {-0.320, 0.947}, "4160,1540": {-0.403, 0.915}, "4160,1640": {-0.403, 0.915}, "4160,1740": {-0.403, 0.915}, "4160,1840": {-0.403, 0.915}, "4160,1940": {-0.464, 0.886}, "4160,2040": {-0.464, 0.886}, "4160,2140": {-0.464, 0.886}, "4160,2240": {-0.464, 0.886}, "4160,2340": {-0.552, 0.834}, "4260,-1660": {-0.737, -0.676}, "4260,-1560": {-0.737, -0.676}, "4260,-1460": {-0.540, -0.842}, "4260,-1360": {-0.540, -0.842}, "4260,-1260": {-0.540, -0.842}, "4260,-1160": {-0.540, -0.842}, "4260,-1060": {-0.540, -0.842}, "4260,-960": {-0.303, -0.953}, "4260,-860": {-0.303, -0.953}, "4260,-760": {-0.303, -0.953}, "4260,-660": {-0.303, -0.953}, "4260,-560": {-0.071, -0.997}, "4260,-460": {-0.071, -0.997}, "4260,-360": {-0.071, -0.997}, "4260,-260": {-0.000, -1.000}, "4260,-160": {-0.000, -1.000}, "4260,-60": {-0.000, -1.000}, "4260,40": {0.000, -1.000}, "4260,140": {0.000, -1.000}, "4260,240": {0.000, -1.000}, "4260,340": {0.000, -1.000}, "4260,440": {0.000, 1.000}, "4260,540": {0.000, 1.000}, "4260,640": {-0.044, 0.999}, "4260,740": {-0.044, 0.999}, "4260,840": {-0.044, 0.999}, "4260,940": {-0.175, 0.985}, "4260,1040": {-0.175, 0.985}, "4260,1140": {-0.320, 0.947}, "4260,1240": {-0.320, 0.947}, "4260,1340": {-0.320, 0.947}, "4260,1440": {-0.320, 0.947}, "4260,1540": {-0.403, 0.915}, "4260,1640": {-0.403, 0.915}, "4260,1740": {-0.403, 0.915}, "4260,1840": {-0.403, 0.915}, "4260,1940": {-0.464, 0.886}, "4260,2040": {-0.464, 0.886}, "4260,2140": {-0.464, 0.886}, "4360,-1560": {-0.540, -0.842}, "4360,-1460": {-0.540, -0.842}, "4360,-1360": {-0.540, -0.842}, "4360,-1260": {-0.540, -0.842}, "4360,-1160": {-0.540, -0.842}, "4360,-1060": {-0.540, -0.842}, "4360,-960": {-0.303, -0.953}, "4360,-860": {-0.303, -0.953}, "4360,-760": {-0.303, -0.953}, "4360,-660": {-0.303, -0.953}, "4360,-560": {-0.071, -0.997}, "4360,-460": {-0.071, -0.997}, "4360,-360": {-0.071, -0.997}, "4360,-260": {-0.000, -1.000}, "4360,-160": {-0.000, -1.000}, "4360,-60": {-0.000, -1.000}, "4360,40": {0.000, -1.000}, "4360,140": {0.000, -1.000}, "4360,240": {0.000, -1.000}, "4360,340": {0.000, -1.000}, "4360,440": {0.000, 1.000}, "4360,540": {0.000, 1.000}, "4360,640": {-0.044, 0.999}, "4360,740": {-0.044, 0.999}, "4360,840": {-0.044, 0.999}, "4360,940": {-0.175, 0.985}, "4360,1040": {-0.175, 0.985}, "4360,1140": {-0.175, 0.985}, "4360,1240": {-0.320, 0.947}, "4360,1340": {-0.320, 0.947}, "4360,1440": {-0.320, 0.947}, "4360,1540": {-0.403, 0.915}, "4360,1640": {-0.403, 0.915}, "4360,1740": {-0.403, 0.915}, "4360,1840": {-0.403, 0.915}, "4360,1940": {-0.403, 0.915}, "4360,2040": {-0.464, 0.886}, "4460,-1560": {-0.540, -0.842}, "4460,-1460": {-0.540, -0.842}, "4460,-1360": {-0.540, -0.842}, "4460,-1260": {-0.540, -0.842}, "4460,-1160": {-0.540, -0.842}, "4460,-1060": {-0.303, -0.953}, "4460,-960": {-0.303, -0.953}, "4460,-860": {-0.303, -0.953}, "4460,-760": {-0.303, -0.953}, "4460,-660": {-0.303, -0.953}, "4460,-560": {-0.071, -0.997}, "4460,-460": {-0.071, -0.997}, "4460,-360": {-0.071, -0.997}, "4460,-260": {-0.000, -1.000}, "4460,-160": {-0.000, -1.000}, "4460,-60": {-0.000, -1.000}, "4460,40": {0.000, -1.000}, "4460,140": {0.000, -1.000}, "4460,240": {0.000, -1.000}, "4460,340": {0.000, -1.000}, "4460,440": {0.000, 1.000}, "4460,540": {0.000, 1.000}, "4460,640": {-0.044, 0.999}, "4460,740": {-0.044, 0.999}, "4460,840": {-0.044, 0.999}, "4460,940": {-0.175, 0.985}, "4460,1040": {-0.175, 0.985}, "4460,1140": {-0.175, 0.985}, "4460,1240": {-0.320, 0.947}, "4460,1340": {-0.320, 0.947}, "4460,1440": {-0.320, 0.947}, "4460,1540": {-0.320, 0.947}, "4560,-1260": {-0.540, -0.842}, "4560,-1160": {-0.540, -0.842}, "4560,-1060": {-0.303, -0.953}, "4560,-960": {-0.303, -0.953}, "4560,-860": {-0.303, -0.953}, "4560,-760": {-0.303, -0.953}, "4560,-660": {-0.303, -0.953}, "4560,-560": {-0.071, -0.997}, "4560,-460": {-0.071, -0.997}, "4560,-360": {-0.071, -0.997}, "4560,-260": {-0.000, -1.000}, "4560,-160": {-0.000, -1.000}, "4560,-60": {-0.000, -1.000}, "4560,40": {0.000, -1.000}, "4560,140": {0.000, -1.000}, "4560,240": {0.000, -1.000}, "4560,340": {0.000, -1.000}, "4560,440": {0.000, 1.000}, "4560,540": {0.000, 1.000}, "4560,640": {-0.044, 0.999}, "4560,740": {-0.044, 0.999}, "4560,840": {-0.044, 0.999}, "4560,940": {-0.175, 0.985}, "4560,1040": {-0.175, 0.985}, "4560,1140": {-0.175, 0.985},
-	},
-	},
+// cells a movement layer can occupy and its flow field per team, keys are grid cells "x,y"
+type DMapLayer struct {
+	Walkable map[string]bool                 `json:"walkable"`
+	Fields   map[string]map[string][]float32 `json:"fields"` //team: "x,y": {dirX, dirY} towards the enemy base
+}
+
+// direction maps by map name (filled from the map files by LoadMaps)
+var MapRegistry = map[string]DMap{}
+
+// movement layer a unit class moves on, air flies over terrain that blocks melee and range units
+func movementLayer(class string) string {
+	if class == "air" {
+		return "air"
+	}
+	return "ground"
 }
@@ -0,0 +1,154 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// events kept per match, clients that fall further behind resync from game-state
+var MatchEventLogSize = 512
+
+// creates the empty event log of a new match
+func createMatchEvents(world cardinal.WorldContext, matchID string) error {
+	_, err := cardinal.Create(world, comp.MatchId{MatchId: matchID}, comp.MatchEvents{NextSeq: 1})
+	if err != nil {
+		return fmt.Errorf("error creating match events (match_events.go/createMatchEvents): %w", err)
+	}
+	return nil
+}
+
+// appends an event stamped with the current tick to the match's log, dropping the oldest once it is full, and
+// publishes it once on the channel of every team that saw it (nakama relays a channel only to that team's player)
+// so effects play on the tick. players that missed some page the gap through match-events
+func emitMatchEvent(world cardinal.WorldContext, matchID string, event comp.MatchEvent) error {
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == matchID
+	})
+	logID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.MatchEvents]())).
+		Where(matchFilter).First(world)
+	if err != nil {
+		return fmt.Errorf("error searching for match events (match_events.go/emitMatchEvent): %w", err)
+	}
+	if logID == iterators.BadID { //matches created before the event log have none
+		return nil
+	}
+
+	//who sees the event is decided by the vision of when it happened, a unit walking into sight later doesn't reveal it
+	vision, err := getVision(world, matchID)
+	if err != nil {
+		return fmt.Errorf("(match_events.go/emitMatchEvent): %w", err)
+	}
+	event.SeenBy = nil
+	for _, team := range visionTeams {
+		if EventVisible(event, team, vision.Teams[team]) {
+			event.SeenBy = append(event.SeenBy, team)
+		}
+	}
+
+	logged := false
+	err = cardinal.UpdateComponent(world, logID, func(events *comp.MatchEvents) *comp.MatchEvents {
+		if events == nil {
+			fmt.Printf("error retrieving match events component (match_events.go/emitMatchEvent): \n")
+			return nil
+		}
+		event.Seq = events.NextSeq
+		event.Tick = world.CurrentTick()
+		events.NextSeq++
+		events.Events = append(events.Events, event)
+		if overflow := len(events.Events) - MatchEventLogSize; overflow > 0 {
+			events.Events = events.Events[overflow:]
+		}
+		logged = true
+		return events
+	})
+	if err != nil {
+		return fmt.Errorf("error updating match events (match_events.go/emitMatchEvent): %w", err)
+	}
+	if !logged {
+		return nil
+	}
+
+	for _, team := range event.SeenBy {
+		if err := world.EmitEvent(gameplayEvent(matchID, team, event)); err != nil {
+			return fmt.Errorf("error publishing match event (match_events.go/emitMatchEvent): %w", err)
+		}
+	}
+	return nil
+}
+
+// MatchEventChannel returns the channel a team's match events are published on
+func MatchEventChannel(matchID, team string) string {
+	return "match/" + matchID + "/" + team
+}
+
+// payload of a match event published to the team that saw it. Seq lines up with the match-events log so a client
+// that missed some can page the gap from there
+func gameplayEvent(matchID, team string, event comp.MatchEvent) map[string]any {
+	payload := map[string]any{
+		"event":   "gameplay",
+		"Channel": MatchEventChannel(matchID, team),
+		"MatchId": matchID,
+		"Seq":     event.Seq,
+		"Tick":    event.Tick,
+		"Type":    event.Type,
+		"UID":     event.UID,
+		"Team":    event.Team,
+		"Name":    event.Name,
+	}
+	if event.Value != 0 {
+		payload["Value"] = event.Value
+	}
+	if event.HeldUID != 0 {
+		payload["HeldUID"] = event.HeldUID
+	}
+	return payload
+}
+
+// emits an event about an entity (unit, structure, projectile or special power) from its own components
+func emitEntityEvent(world cardinal.WorldContext, id types.EntityID, eventType string) error {
+	matchID, event, err := entityEvent(world, id, eventType)
+	if err != nil {
+		return err
+	}
+	return emitMatchEvent(world, matchID, event)
+}
+
+// match and event about an entity filled in from its components
+func entityEvent(world cardinal.WorldContext, id types.EntityID, eventType string) (string, comp.MatchEvent, error) {
+	matchID, uid, err := GetComponents2[comp.MatchId, comp.UID](world, id)
+	if err != nil {
+		return "", comp.MatchEvent{}, fmt.Errorf("(match_events.go/entityEvent): %w", err)
+	}
+	event := comp.MatchEvent{Type: eventType, UID: uid.UID}
+	if team, err := cardinal.GetComponent[comp.Team](world, id); err == nil {
+		event.Team = team.Team
+	}
+	if name, err := cardinal.GetComponent[comp.UnitName](world, id); err == nil {
+		event.Name = name.UnitName
+	} else if spName, err := cardinal.GetComponent[comp.SpName](world, id); err == nil {
+		event.Name = spName.SpName
+	}
+	if _, err := cardinal.GetComponent[comp.StructureTag](world, id); err == nil {
+		event.Structure = true
+	}
+	return matchID.MatchId, event, nil
+}
+
+// EventVisible returns true if team may see the event. a team sees everything about its own side, structures,
+// and enemy units, projectiles and special powers in its sight (TeamVision.Visible). enemy gold is never shown
+func EventVisible(event comp.MatchEvent, team string, vision comp.TeamVision) bool {
+	if event.Team == team {
+		return true
+	}
+	if event.Type == "gold" {
+		return false
+	}
+	return event.Structure || vision.Visible[event.UID]
+}
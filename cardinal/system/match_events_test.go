@@ -0,0 +1,76 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestGameplayEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		team  string
+		event comp.MatchEvent
+		want  map[string]any
+	}{
+		{
+			name:  "unit event",
+			team:  "Red",
+			event: comp.MatchEvent{Seq: 7, Tick: 120, Type: "destroyed", UID: 12, Team: "Blue", Name: "Mage", SeenBy: []string{"Blue", "Red"}},
+			want: map[string]any{
+				"event": "gameplay", "Channel": "match/m1/Red", "MatchId": "m1",
+				"Seq": uint64(7), "Tick": uint64(120), "Type": "destroyed", "UID": 12, "Team": "Blue", "Name": "Mage",
+			},
+		},
+		{
+			name:  "value and held uid only when set",
+			team:  "Blue",
+			event: comp.MatchEvent{Seq: 3, Tick: 40, Type: "spawned", UID: 5, Team: "Blue", Name: "Vampire", HeldUID: 2, Value: 1.5},
+			want: map[string]any{
+				"event": "gameplay", "Channel": "match/m1/Blue", "MatchId": "m1",
+				"Seq": uint64(3), "Tick": uint64(40), "Type": "spawned", "UID": 5, "Team": "Blue", "Name": "Vampire",
+				"HeldUID": 2, "Value": float32(1.5),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gameplayEvent("m1", tt.team, tt.event); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("gameplayEvent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchEventChannel(t *testing.T) {
+	if blue, red := MatchEventChannel("m1", "Blue"), MatchEventChannel("m1", "Red"); blue == red {
+		t.Errorf("both teams publish on %q", blue)
+	}
+	if a, b := MatchEventChannel("m1", "Blue"), MatchEventChannel("m2", "Blue"); a == b {
+		t.Errorf("both matches publish on %q", a)
+	}
+}
+
+func TestEventVisible(t *testing.T) {
+	vision := comp.TeamVision{Visible: map[int]bool{7: true}}
+	tests := []struct {
+		name  string
+		event comp.MatchEvent
+		want  bool
+	}{
+		{"own unit", comp.MatchEvent{Type: "spawned", UID: 3, Team: "Blue"}, true},
+		{"own gold", comp.MatchEvent{Type: "gold", Team: "Blue", Value: 4}, true},
+		{"enemy gold", comp.MatchEvent{Type: "gold", Team: "Red", Value: 4}, false},
+		{"enemy unit in sight", comp.MatchEvent{Type: "damaged", UID: 7, Team: "Red"}, true},
+		{"enemy unit out of sight", comp.MatchEvent{Type: "spawned", UID: 8, Team: "Red"}, false},
+		{"enemy structure out of sight", comp.MatchEvent{Type: "towerConverted", UID: 9, Team: "Red", Structure: true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EventVisible(tt.event, "Blue", vision); got != tt.want {
+				t.Errorf("EventVisible(%+v) = %v, want %v", tt.event, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+)
+
+// ticks players have to acknowledge a result before the match entities are cleaned up anyway
+var ResultAckTimeoutTicks = 600
+
+// ends a match: records its result and history, archives the seed and freezes the match until both players acknowledge.
+// winner is the winning team, empty on a draw or when the match is aborted
+func endMatch(world cardinal.WorldContext, matchID, winner, reason string) error {
+	gameState, err := getGameStateGSS(world, &comp.MatchId{MatchId: matchID})
+	if err != nil {
+		//match never started (no player2), nothing to record
+		if err := removeReplay(world, matchID); err != nil {
+			return fmt.Errorf("(match_result.go/endMatch): %w", err)
+		}
+		return RemoveAllEntitiesSystem(world, matchID)
+	}
+
+	p1, p2, err := getPlayerComponentsGSS(world, gameState)
+	if err != nil {
+		return fmt.Errorf("(match_result.go/endMatch): %w", err)
+	}
+	timer, stats, mapName, err := GetComponents3[comp.MatchTimer, comp.MatchStats, comp.MapName](world, gameState)
+	if err != nil {
+		return fmt.Errorf("(match_result.go/endMatch): %w", err)
+	}
+	if timer.Phase == "Ended" { //already recorded
+		return nil
+	}
+
+	result := comp.MatchResult{
+		MatchId:             matchID,
+		BluePlayer:          p1.Nickname,
+		RedPlayer:           p2.Nickname,
+		WinningTeam:         winner,
+		Reason:              reason,
+		DurationTicks:       timer.ElapsedTicks,
+		EndTick:             world.CurrentTick(),
+		TowersDestroyedBlue: stats.TowersDestroyed["Blue"],
+		TowersDestroyedRed:  stats.TowersDestroyed["Red"],
+		AckBlue:             isBot(p1.Nickname), //bots have nothing to acknowledge
+		AckRed:              isBot(p2.Nickname),
+		AckDeadlineTick:     world.CurrentTick() + uint64(ResultAckTimeoutTicks),
+	}
+	switch winner {
+	case "Blue":
+		result.Winner, result.Loser = p1.Nickname, p2.Nickname
+	case "Red":
+		result.Winner, result.Loser = p2.Nickname, p1.Nickname
+	}
+
+	//final base health
+	err = cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.StructureTag]())).
+		Where(structureInMatch(matchID, "Base")).
+		Each(world, func(id types.EntityID) bool {
+			team, health, err := GetComponents2[comp.Team, comp.Health](world, id)
+			if err != nil {
+				fmt.Printf("error getting base components (match_result.go/endMatch): %v \n", err)
+				return false
+			}
+			if team.Team == "Blue" {
+				result.BlueBaseHP = health.CurrentHP
+			} else {
+				result.RedBaseHP = health.CurrentHP
+			}
+			return true
+		})
+	if err != nil {
+		return fmt.Errorf("error reading base health (match_result.go/endMatch): %w", err)
+	}
+
+	if _, err := cardinal.Create(world, result); err != nil {
+		return fmt.Errorf("error creating match result (match_result.go/endMatch): %w", err)
+	}
+
+	_, err = cardinal.Create(world, comp.MatchHistory{
+		MatchId:       matchID,
+		MapName:       mapName.MapName,
+		BluePlayer:    result.BluePlayer,
+		RedPlayer:     result.RedPlayer,
+		WinningTeam:   result.WinningTeam,
+		Reason:        result.Reason,
+		DurationTicks: result.DurationTicks,
+		EndTick:       result.EndTick,
+		Stats:         *stats,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating match history (match_result.go/endMatch): %w", err)
+	}
+
+	if err := updatePlayerProfiles(world, &result); err != nil {
+		return fmt.Errorf("error updating player profiles (match_result.go/endMatch): %w", err)
+	}
+
+	if err := archiveMatchSeed(world, matchID); err != nil {
+		return fmt.Errorf("error archiving seed (match_result.go/endMatch): %w", err)
+	}
+
+	if err := finishReplay(world, matchID); err != nil {
+		return fmt.Errorf("error finishing replay (match_result.go/endMatch): %w", err)
+	}
+
+	//freeze the match, the acknowledgement deadline is kept in the result so no match time is left
+	timer.Phase = "Ended"
+	timer.PhaseTicksLeft = 0
+	if err := cardinal.SetComponent(world, gameState, timer); err != nil {
+		return fmt.Errorf("error setting match timer (match_result.go/endMatch): %w", err)
+	}
+	return nil
+}
+
+// records a players acknowledgement of the result, cleans up the match once both players have seen it
+func AckResultSystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(world,
+		func(ack cardinal.TxData[msg.AckResultMsg]) (msg.AckResultResult, error) {
+			resultID, result, err := getMatchResult(world, ack.Msg.MatchID)
+			if err != nil {
+				return msg.AckResultResult{Success: false}, fmt.Errorf("(match_result.go/AckResultSystem): %w", err)
+			}
+
+			switch ack.Tx.PersonaTag {
+			case result.BluePlayer:
+				result.AckBlue = true
+			case result.RedPlayer:
+				result.AckRed = true
+			default:
+				return msg.AckResultResult{Success: false}, fmt.Errorf("%w (match_result.go/AckResultSystem): %s", ErrNotInMatch, ack.Tx.PersonaTag)
+			}
+
+			if err := cardinal.SetComponent(world, resultID, result); err != nil {
+				return msg.AckResultResult{Success: false}, fmt.Errorf("error setting match result (match_result.go/AckResultSystem): %w", err)
+			}
+
+			if result.AckBlue && result.AckRed {
+				if err := RemoveAllEntitiesSystem(world, ack.Msg.MatchID); err != nil {
+					return msg.AckResultResult{Success: false}, fmt.Errorf("(match_result.go/AckResultSystem): %w", err)
+				}
+			}
+			return msg.AckResultResult{Success: true}, nil
+		})
+}
+
+// result entity of a finished match
+func getMatchResult(world cardinal.WorldContext, matchID string) (types.EntityID, *comp.MatchResult, error) {
+	resultFilter := cardinal.ComponentFilter(func(m comp.MatchResult) bool {
+		return m.MatchId == matchID
+	})
+	resultID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchResult]())).
+		Where(resultFilter).First(world)
+	if err != nil {
+		return resultID, nil, fmt.Errorf("error searching for match result (getMatchResult): %w", err)
+	}
+	if resultID == iterators.BadID {
+		return resultID, nil, fmt.Errorf("no result found for match (getMatchResult): %s", matchID)
+	}
+
+	result, err := cardinal.GetComponent[comp.MatchResult](world, resultID)
+	if err != nil {
+		return resultID, nil, fmt.Errorf("error getting match result component (getMatchResult): %w", err)
+	}
+	return resultID, result, nil
+}
+
+// ids of matches that have ended and are waiting on cleanup
+func endedMatchIDs(world cardinal.WorldContext) (map[string]bool, error) {
+	ended := make(map[string]bool)
+	err := cardinal.NewSearch().Entity(
+		filter.Exact(GameStateFilters()...)).
+		Each(world, func(id types.EntityID) bool {
+			matchID, timer, err := GetComponents2[comp.MatchId, comp.MatchTimer](world, id)
+			if err != nil {
+				fmt.Printf("error getting timer components (endedMatchIDs): %v \n", err)
+				return false
+			}
+			if timer.Phase == "Ended" {
+				ended[matchID.MatchId] = true
+			}
+			return true
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error searching for ended matches (endedMatchIDs): %w", err)
+	}
+	return ended, nil
+}
+
+// filter for entities of matches still being played, gameplay systems use it so ended matches stay frozen
+func activeMatchFilter(world cardinal.WorldContext) (cardinal.FilterFn, error) {
+	ended, err := endedMatchIDs(world)
+	if err != nil {
+		return nil, err
+	}
+	return cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return !ended[m.MatchId]
+	}), nil
+}
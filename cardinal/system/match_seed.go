@@ -0,0 +1,120 @@
+package system
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// seed for a new match from its id and the transaction that created it
+func newMatchSeed(matchID string, txHash types.TxHash) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(matchID))
+	h.Write([]byte(txHash))
+	return h.Sum64()
+}
+
+// mixes the joining player's transaction into the seed so neither player picks it alone
+func mixMatchSeed(seed uint64, txHash types.TxHash) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%d", seed)))
+	h.Write([]byte(txHash))
+	return h.Sum64()
+}
+
+// splitmix64 generator, small and fully deterministic across platforms
+type seededRand struct {
+	state uint64
+}
+
+func (r *seededRand) next() uint64 {
+	r.state += 0x9e3779b97f4a7c15
+	z := r.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// fisher yates shuffle of the deck in place
+func shuffleDeck(rng *seededRand, deck []string) {
+	for i := len(deck) - 1; i > 0; i-- {
+		j := int(rng.next() % uint64(i+1))
+		deck[i], deck[j] = deck[j], deck[i]
+	}
+}
+
+// finalizes the match seed when player2 joins, then shuffles both players cards and deals new hands.
+// player1 is shuffled before player2 so the draw order only depends on the seed
+func shuffleDecksGSS(world cardinal.WorldContext, gameState types.EntityID, txHash types.TxHash) error {
+	seed, err := cardinal.GetComponent[comp.MatchSeed](world, gameState)
+	if err != nil {
+		return fmt.Errorf("error getting match seed component (match_seed.go): %w", err)
+	}
+	p1, p2, err := getPlayerComponentsGSS(world, gameState)
+	if err != nil {
+		return fmt.Errorf("(match_seed.go): %w", err)
+	}
+
+	seed.Seed = mixMatchSeed(seed.Seed, txHash)
+	rng := &seededRand{state: seed.Seed}
+
+	cards := append(append([]string(nil), p1.Hand...), p1.Deck...)
+	shuffleDeck(rng, cards)
+	p1.Hand, p1.Deck = dealHand(cards)
+
+	cards = append(append([]string(nil), p2.Hand...), p2.Deck...)
+	shuffleDeck(rng, cards)
+	p2.Hand, p2.Deck = dealHand(cards)
+
+	if err := SetComponents3(world, gameState, seed, p1, p2); err != nil {
+		return fmt.Errorf("error setting shuffled decks (match_seed.go): %w", err)
+	}
+	return nil
+}
+
+// copies the seed of a finished match into its own entity so it can be read after the game state is removed.
+// the archive has no MatchId component so match cleanup leaves it alone. an archive left by an earlier match with the
+// same id is overwritten so the match-seed query never returns a stale seed
+func archiveMatchSeed(world cardinal.WorldContext, matchID string) error {
+	seedFilter := cardinal.ComponentFilter(func(m comp.MatchSeed) bool {
+		return m.MatchId == matchID
+	})
+
+	gameState, err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.MatchId](), filter.Component[comp.MatchSeed]())).
+		Where(seedFilter).First(world)
+	if err != nil {
+		return fmt.Errorf("error searching for match seed (match_seed.go): %w", err)
+	}
+	if gameState == iterators.BadID { //no game state for the match
+		return nil
+	}
+	seed, err := cardinal.GetComponent[comp.MatchSeed](world, gameState)
+	if err != nil {
+		return fmt.Errorf("error getting match seed component (match_seed.go): %w", err)
+	}
+
+	archive, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchSeed]())).
+		Where(seedFilter).First(world)
+	if err != nil {
+		return fmt.Errorf("error searching for archived seed (match_seed.go): %w", err)
+	}
+	if archive != iterators.BadID {
+		if err := cardinal.SetComponent(world, archive, seed); err != nil {
+			return fmt.Errorf("error overwriting archived seed (match_seed.go): %w", err)
+		}
+		return nil
+	}
+
+	if _, err := cardinal.Create(world, *seed); err != nil {
+		return fmt.Errorf("error archiving match seed (match_seed.go): %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package system
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+func TestSeededRandNext(t *testing.T) {
+	tests := []struct {
+		name string
+		seed uint64
+		want []uint64
+	}{
+		//reference splitmix64 outputs
+		{"seed 0", 0, []uint64{0xe220a8397b1dcdaf, 0x6e789e6aa1b965f4, 0x06c45d188009454f}},
+		{"seed 1234567", 1234567, []uint64{6457827717110365317, 3203168211198807973, 9817491932198370423}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rng := &seededRand{state: tt.seed}
+			for i, want := range tt.want {
+				if got := rng.next(); got != want {
+					t.Errorf("next() call %d = %#x, want %#x", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestShuffleDeck(t *testing.T) {
+	deck := []string{"ArcherLady", "FireSpirit", "LavaGolem", "LeafBird", "Mage", "Vampire", "Mage", "Vampire"}
+	tests := []struct {
+		name string
+		seed uint64
+		deck []string
+		want []string
+	}{
+		{"seed 0", 0, deck, []string{"LavaGolem", "Vampire", "ArcherLady", "LeafBird", "Mage", "Mage", "FireSpirit", "Vampire"}},
+		{"seed 42", 42, deck, []string{"LeafBird", "FireSpirit", "Mage", "LavaGolem", "Mage", "ArcherLady", "Vampire", "Vampire"}},
+		{"two cards", 42, []string{"a", "b"}, []string{"a", "b"}},
+		{"one card", 42, []string{"a"}, []string{"a"}},
+		{"empty", 42, []string{}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := append([]string{}, tt.deck...)
+			shuffleDeck(&seededRand{state: tt.seed}, got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("shuffleDeck() = %v, want %v", got, tt.want)
+			}
+			//a shuffle only reorders cards
+			sortedGot, sortedDeck := slices.Clone(got), slices.Clone(tt.deck)
+			slices.Sort(sortedGot)
+			slices.Sort(sortedDeck)
+			if !slices.Equal(sortedGot, sortedDeck) {
+				t.Errorf("shuffleDeck() = %v, not a permutation of %v", got, tt.deck)
+			}
+		})
+	}
+}
+
+func TestNewMatchSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		matchID string
+		txHash  string
+		want    uint64
+	}{
+		{"fnv offset basis", "", "", 0xcbf29ce484222325},
+		{"match and tx", "match-1", "tx", 0x3f0c477742d5999c},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newMatchSeed(tt.matchID, types.TxHash(tt.txHash)); got != tt.want {
+				t.Errorf("newMatchSeed(%q, %q) = %#x, want %#x", tt.matchID, tt.txHash, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMixMatchSeed(t *testing.T) {
+	seed := newMatchSeed("match-1", "tx")
+	mixed := mixMatchSeed(seed, "tx2")
+	if mixed == seed {
+		t.Errorf("mixMatchSeed() = %#x, want the second player's hash to change the seed", mixed)
+	}
+	if again := mixMatchSeed(seed, "tx2"); again != mixed {
+		t.Errorf("mixMatchSeed() = %#x then %#x, want the same seed for the same inputs", mixed, again)
+	}
+	if other := mixMatchSeed(seed, "tx3"); other == mixed {
+		t.Errorf("mixMatchSeed() = %#x for different hashes, want different seeds", other)
+	}
+}
@@ -0,0 +1,97 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// empty stats with an entry for every team
+func newMatchStats() comp.MatchStats {
+	stats := comp.MatchStats{
+		TowersDestroyed: make(map[string]int),
+		DamageDealt:     make(map[string]map[string]float32),
+		DamageTaken:     make(map[string]map[string]float32),
+		Kills:           make(map[string]map[string]int),
+		CardsPlayed:     make(map[string]map[string]int),
+		GoldSpent:       make(map[string]int),
+	}
+	for _, team := range MapTeams {
+		stats.DamageDealt[team] = make(map[string]float32)
+		stats.DamageTaken[team] = make(map[string]float32)
+		stats.Kills[team] = make(map[string]int)
+		stats.CardsPlayed[team] = make(map[string]int)
+	}
+	return stats
+}
+
+// unit type and team credited with damage from an entity, projectiles and sp entities credit their owner
+func damageSource(world cardinal.WorldContext, id types.EntityID) (comp.Owner, error) {
+	if owner, err := cardinal.GetComponent[comp.Owner](world, id); err == nil {
+		return *owner, nil
+	}
+	name, team, err := GetComponents2[comp.UnitName, comp.Team](world, id)
+	if err != nil {
+		return comp.Owner{}, fmt.Errorf("error getting damage source components (match_stats.go/damageSource): %w", err)
+	}
+	return comp.Owner{UnitName: name.UnitName, Team: team.Team}, nil
+}
+
+// adds damage dealt by source to targetID to the match stats, killed if it took the target to 0 hp
+func recordDamage(world cardinal.WorldContext, source comp.Owner, targetID types.EntityID, dealt float32, killed bool) error {
+	targetName, targetTeam, matchID, err := GetComponents3[comp.UnitName, comp.Team, comp.MatchId](world, targetID)
+	if err != nil {
+		return fmt.Errorf("error getting target components (match_stats.go/recordDamage): %w", err)
+	}
+	gameState, err := getGameStateGSS(world, matchID)
+	if err != nil {
+		return fmt.Errorf("(match_stats.go/recordDamage): %w", err)
+	}
+
+	err = cardinal.UpdateComponent(world, gameState, func(stats *comp.MatchStats) *comp.MatchStats {
+		if stats == nil {
+			fmt.Printf("error retrieving match stats component (match_stats.go/recordDamage): \n")
+			return nil
+		}
+		addDamage(stats, source, targetTeam.Team, targetName.UnitName, dealt, killed)
+		return stats
+	})
+	if err != nil {
+		return fmt.Errorf("error updating match stats (match_stats.go/recordDamage): %w", err)
+	}
+	return nil
+}
+
+// adds a played card and the gold spent on it to the match stats
+func recordCardPlayed(world cardinal.WorldContext, gameState types.EntityID, team, card string, cost int) error {
+	err := cardinal.UpdateComponent(world, gameState, func(stats *comp.MatchStats) *comp.MatchStats {
+		if stats == nil {
+			fmt.Printf("error retrieving match stats component (match_stats.go/recordCardPlayed): \n")
+			return nil
+		}
+		addCardPlayed(stats, team, card, cost)
+		return stats
+	})
+	if err != nil {
+		return fmt.Errorf("error updating match stats (match_stats.go/recordCardPlayed): %w", err)
+	}
+	return nil
+}
+
+// credits damage source dealt to a target of targetTeam, and the kill if it finished the target off
+func addDamage(stats *comp.MatchStats, source comp.Owner, targetTeam, targetName string, dealt float32, killed bool) {
+	stats.DamageDealt[source.Team][source.UnitName] += dealt
+	stats.DamageTaken[targetTeam][targetName] += dealt
+	if killed {
+		stats.Kills[source.Team][source.UnitName]++
+	}
+}
+
+// counts a card team played and the gold it cost
+func addCardPlayed(stats *comp.MatchStats, team, card string, cost int) {
+	stats.CardsPlayed[team][card]++
+	stats.GoldSpent[team] += cost
+}
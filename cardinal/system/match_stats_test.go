@@ -0,0 +1,96 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestNewMatchStats(t *testing.T) {
+	stats := newMatchStats()
+	//every team has its maps so recording never writes to a nil map
+	for _, team := range MapTeams {
+		if stats.DamageDealt[team] == nil || stats.DamageTaken[team] == nil || stats.Kills[team] == nil || stats.CardsPlayed[team] == nil {
+			t.Errorf("newMatchStats() is missing the maps of %s", team)
+		}
+	}
+	if stats.TowersDestroyed == nil || stats.GoldSpent == nil {
+		t.Errorf("newMatchStats() is missing the team totals")
+	}
+}
+
+func TestAddDamage(t *testing.T) {
+	type hit struct {
+		source     comp.Owner
+		targetTeam string
+		targetName string
+		dealt      float32
+		killed     bool
+	}
+	mage := comp.Owner{UnitName: "Mage", Team: "Blue"}
+	archer := comp.Owner{UnitName: "ArcherLady", Team: "Red"}
+	tests := []struct {
+		name      string
+		hits      []hit
+		wantDealt map[string]map[string]float32
+		wantTaken map[string]map[string]float32
+		wantKills map[string]map[string]int
+	}{
+		{
+			name:      "one hit",
+			hits:      []hit{{mage, "Red", "Vampire", 30, false}},
+			wantDealt: map[string]map[string]float32{"Blue": {"Mage": 30}, "Red": {}},
+			wantTaken: map[string]map[string]float32{"Blue": {}, "Red": {"Vampire": 30}},
+			wantKills: map[string]map[string]int{"Blue": {}, "Red": {}},
+		},
+		{
+			name:      "hits add up and the last one gets the kill",
+			hits:      []hit{{mage, "Red", "Vampire", 30, false}, {mage, "Red", "Vampire", 20, true}},
+			wantDealt: map[string]map[string]float32{"Blue": {"Mage": 50}, "Red": {}},
+			wantTaken: map[string]map[string]float32{"Blue": {}, "Red": {"Vampire": 50}},
+			wantKills: map[string]map[string]int{"Blue": {"Mage": 1}, "Red": {}},
+		},
+		{
+			name:      "both teams",
+			hits:      []hit{{mage, "Red", "ArcherLady", 10, false}, {archer, "Blue", "Mage", 15, true}, {archer, "Blue", "Tower", 5, false}},
+			wantDealt: map[string]map[string]float32{"Blue": {"Mage": 10}, "Red": {"ArcherLady": 20}},
+			wantTaken: map[string]map[string]float32{"Blue": {"Mage": 15, "Tower": 5}, "Red": {"ArcherLady": 10}},
+			wantKills: map[string]map[string]int{"Blue": {}, "Red": {"ArcherLady": 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := newMatchStats()
+			for _, h := range tt.hits {
+				addDamage(&stats, h.source, h.targetTeam, h.targetName, h.dealt, h.killed)
+			}
+			if !reflect.DeepEqual(stats.DamageDealt, tt.wantDealt) {
+				t.Errorf("DamageDealt = %v, want %v", stats.DamageDealt, tt.wantDealt)
+			}
+			if !reflect.DeepEqual(stats.DamageTaken, tt.wantTaken) {
+				t.Errorf("DamageTaken = %v, want %v", stats.DamageTaken, tt.wantTaken)
+			}
+			if !reflect.DeepEqual(stats.Kills, tt.wantKills) {
+				t.Errorf("Kills = %v, want %v", stats.Kills, tt.wantKills)
+			}
+		})
+	}
+}
+
+func TestAddCardPlayed(t *testing.T) {
+	stats := newMatchStats()
+	addCardPlayed(&stats, "Blue", "Mage", 3)
+	addCardPlayed(&stats, "Blue", "Mage", 3)
+	addCardPlayed(&stats, "Blue", "LavaGolem", 5)
+	addCardPlayed(&stats, "Red", "LeafBird", 2)
+
+	wantCards := map[string]map[string]int{"Blue": {"Mage": 2, "LavaGolem": 1}, "Red": {"LeafBird": 1}}
+	if !reflect.DeepEqual(stats.CardsPlayed, wantCards) {
+		t.Errorf("CardsPlayed = %v, want %v", stats.CardsPlayed, wantCards)
+	}
+	wantGold := map[string]int{"Blue": 11, "Red": 2}
+	if !reflect.DeepEqual(stats.GoldSpent, wantGold) {
+		t.Errorf("GoldSpent = %v, want %v", stats.GoldSpent, wantGold)
+	}
+}
@@ -0,0 +1,231 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// phase lengths in ticks (100ms tickrate, 1800 = 3 minutes)
+var RegulationTicks = 1800
+var OvertimeTicks = 600
+var SuddenDeathTicks = 600
+
+// gold generation multiplier for each phase of the match clock
+var phaseGoldMultiplier = map[string]float32{
+	"Regulation":  1,
+	"Overtime":    2,
+	"SuddenDeath": 3,
+}
+
+// phase that follows when a phase runs out tied
+var nextPhase = map[string]string{
+	"Regulation": "Overtime",
+	"Overtime":   "SuddenDeath",
+}
+
+// length of a phase in ticks
+func phaseLength(phase string) int {
+	switch phase {
+	case "Overtime":
+		return OvertimeTicks
+	case "SuddenDeath":
+		return SuddenDeathTicks
+	default:
+		return RegulationTicks
+	}
+}
+
+// clock of a newly created match
+func newMatchTimer() comp.MatchTimer {
+	return comp.MatchTimer{Phase: "Regulation", PhaseTicksLeft: phaseLength("Regulation")}
+}
+
+// advances the clock of every started match.
+// when regulation or overtime run out the team holding more towers wins, otherwise the next phase starts.
+// in sudden death the first tower lead wins and when it runs out remaining base health decides, then a draw.
+// ended matches are cleaned up once their result's acknowledgement deadline passes
+func MatchTimerSystem(world cardinal.WorldContext) error {
+	var ended []matchEnding
+	var expired []string
+
+	err := cardinal.NewSearch().Entity(
+		filter.Exact(GameStateFilters()...)).
+		Each(world, func(id types.EntityID) bool {
+			matchID, timer, err := GetComponents2[comp.MatchId, comp.MatchTimer](world, id)
+			if err != nil {
+				fmt.Printf("error getting timer components (match_timer.go): %v \n", err)
+				return false
+			}
+
+			if timer.Phase == "Ended" {
+				_, result, err := getMatchResult(world, matchID.MatchId)
+				if err != nil {
+					fmt.Printf("(match_timer.go): %v \n", err)
+					return true
+				}
+				if world.CurrentTick() >= result.AckDeadlineTick {
+					expired = append(expired, matchID.MatchId)
+				}
+				return true
+			}
+
+			if tickMatchTimer(timer) {
+				blueTowers, redTowers, err := countTowers(world, matchID.MatchId)
+				if err != nil {
+					fmt.Printf("(match_timer.go): %v \n", err)
+					return false
+				}
+
+				winner, tiebreak := settleMatchTimer(timer, blueTowers, redTowers)
+				if winner != "" {
+					ended = append(ended, matchEnding{matchID.MatchId, winner, "towers"})
+				} else if tiebreak {
+					//sudden death ran out, fall back to base health
+					ending, err := baseHealthTiebreak(world, matchID.MatchId)
+					if err != nil {
+						fmt.Printf("(match_timer.go): %v \n", err)
+						return false
+					}
+					ended = append(ended, ending)
+				}
+			}
+
+			if err := cardinal.SetComponent(world, id, timer); err != nil {
+				fmt.Printf("error setting match timer (match_timer.go): %v \n", err)
+				return false
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+
+	//end matches after the search so entities are not removed mid iteration
+	for _, ending := range ended {
+		if err := endMatch(world, ending.matchID, ending.winner, ending.reason); err != nil {
+			fmt.Printf("(match_timer.go): %v \n", err)
+		}
+	}
+	//results nobody acknowledged in time
+	for _, matchID := range expired {
+		if err := RemoveAllEntitiesSystem(world, matchID); err != nil {
+			fmt.Printf("(match_timer.go): %v \n", err)
+		}
+	}
+	return nil
+}
+
+// advances the clock one tick, true if the towers each team holds can decide anything this tick
+func tickMatchTimer(timer *comp.MatchTimer) bool {
+	timer.ElapsedTicks++
+	timer.PhaseTicksLeft--
+	return timer.Phase == "SuddenDeath" || timer.PhaseTicksLeft <= 0
+}
+
+// settles a tick tickMatchTimer flagged: the team with more towers wins, a tied phase that ran out moves on to the
+// next one. true when sudden death ran out tied and base health has to decide
+func settleMatchTimer(timer *comp.MatchTimer, blueTowers, redTowers float32) (string, bool) {
+	if winner := leadingTeam(blueTowers, redTowers); winner != "" {
+		return winner, false
+	}
+	if timer.PhaseTicksLeft > 0 {
+		return "", false
+	}
+	if next, ok := nextPhase[timer.Phase]; ok {
+		timer.Phase = next
+		timer.PhaseTicksLeft = phaseLength(next)
+		return "", false
+	}
+	return "", true
+}
+
+type matchEnding struct {
+	matchID string
+	winner  string //empty on a draw
+	reason  string
+}
+
+// team with the higher count, empty when tied
+func leadingTeam(blue, red float32) string {
+	if blue > red {
+		return "Blue"
+	} else if red > blue {
+		return "Red"
+	}
+	return ""
+}
+
+// towers each team currently holds in a match
+func countTowers(world cardinal.WorldContext, matchID string) (float32, float32, error) {
+	var blue, red float32
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.StructureTag]())).
+		Where(structureInMatch(matchID, "Tower")).
+		Each(world, func(id types.EntityID) bool {
+			team, err := cardinal.GetComponent[comp.Team](world, id)
+			if err != nil {
+				fmt.Printf("error getting tower team (match_timer.go): %v \n", err)
+				return false
+			}
+			if team.Team == "Blue" {
+				blue++
+			} else {
+				red++
+			}
+			return true
+		})
+	if err != nil {
+		return 0, 0, fmt.Errorf("error counting towers (match_timer.go): %w", err)
+	}
+	return blue, red, nil
+}
+
+// final tiebreak on remaining base health, a draw if that is tied too
+func baseHealthTiebreak(world cardinal.WorldContext, matchID string) (matchEnding, error) {
+	var blue, red float32
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.StructureTag]())).
+		Where(structureInMatch(matchID, "Base")).
+		Each(world, func(id types.EntityID) bool {
+			team, health, err := GetComponents2[comp.Team, comp.Health](world, id)
+			if err != nil {
+				fmt.Printf("error getting base components (match_timer.go): %v \n", err)
+				return false
+			}
+			if team.Team == "Blue" {
+				blue = health.CurrentHP
+			} else {
+				red = health.CurrentHP
+			}
+			return true
+		})
+	if err != nil {
+		return matchEnding{}, fmt.Errorf("error comparing base health (match_timer.go): %w", err)
+	}
+
+	return tiebreakEnding(matchID, blue, red), nil
+}
+
+// ending of a match sudden death left tied, on the health left in each base
+func tiebreakEnding(matchID string, blueHP, redHP float32) matchEnding {
+	if winner := leadingTeam(blueHP, redHP); winner != "" {
+		return matchEnding{matchID, winner, "baseHealth"}
+	}
+	return matchEnding{matchID, "", "draw"}
+}
+
+// filter for the structures of a match with the given unit name
+func structureInMatch(matchID, unitName string) cardinal.FilterFn {
+	return cardinal.AndFilter(
+		cardinal.ComponentFilter(func(m comp.MatchId) bool {
+			return m.MatchId == matchID
+		}),
+		cardinal.ComponentFilter(func(m comp.UnitName) bool {
+			return m.UnitName == unitName
+		}))
+}
@@ -0,0 +1,141 @@
+package system
+
+import (
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+// runs a new match clock until it is decided or limit ticks pass. towers gives the towers each team holds at an
+// elapsed tick
+func runMatchTimer(limit int, towers func(elapsed int) (float32, float32)) (comp.MatchTimer, string, bool) {
+	timer := newMatchTimer()
+	for i := 0; i < limit; i++ {
+		if !tickMatchTimer(&timer) {
+			continue
+		}
+		blue, red := towers(timer.ElapsedTicks)
+		if winner, tiebreak := settleMatchTimer(&timer, blue, red); winner != "" || tiebreak {
+			return timer, winner, tiebreak
+		}
+	}
+	return timer, "", false
+}
+
+func TestMatchTimerPhases(t *testing.T) {
+	regulation := RegulationTicks
+	overtime := regulation + OvertimeTicks
+	suddenDeath := overtime + SuddenDeathTicks
+	tied := func(int) (float32, float32) { return 3, 3 }
+	tests := []struct {
+		name         string
+		towers       func(elapsed int) (float32, float32)
+		wantElapsed  int
+		wantPhase    string
+		wantWinner   string
+		wantTiebreak bool
+	}{
+		{
+			name:        "lead is only checked when regulation runs out",
+			towers:      func(int) (float32, float32) { return 3, 2 },
+			wantElapsed: regulation,
+			wantPhase:   "Regulation",
+			wantWinner:  "Blue",
+		},
+		{
+			name: "tied regulation goes to overtime",
+			towers: func(elapsed int) (float32, float32) {
+				if elapsed <= regulation {
+					return 3, 3
+				}
+				return 2, 3
+			},
+			wantElapsed: overtime,
+			wantPhase:   "Overtime",
+			wantWinner:  "Red",
+		},
+		{
+			name: "first lead in sudden death wins",
+			towers: func(elapsed int) (float32, float32) {
+				if elapsed < overtime+100 {
+					return 3, 3
+				}
+				return 3, 2
+			},
+			wantElapsed: overtime + 100,
+			wantPhase:   "SuddenDeath",
+			wantWinner:  "Blue",
+		},
+		{
+			name:         "sudden death running out tied goes to base health",
+			towers:       tied,
+			wantElapsed:  suddenDeath,
+			wantPhase:    "SuddenDeath",
+			wantTiebreak: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timer, winner, tiebreak := runMatchTimer(suddenDeath+100, tt.towers)
+			if winner != tt.wantWinner || tiebreak != tt.wantTiebreak {
+				t.Errorf("ended with winner %q tiebreak %v, want %q %v", winner, tiebreak, tt.wantWinner, tt.wantTiebreak)
+			}
+			if timer.ElapsedTicks != tt.wantElapsed || timer.Phase != tt.wantPhase {
+				t.Errorf("ended at tick %d in %s, want tick %d in %s", timer.ElapsedTicks, timer.Phase, tt.wantElapsed, tt.wantPhase)
+			}
+		})
+	}
+}
+
+func TestSettleMatchTimer(t *testing.T) {
+	tests := []struct {
+		name         string
+		timer        comp.MatchTimer
+		blue, red    float32
+		want         comp.MatchTimer
+		wantWinner   string
+		wantTiebreak bool
+	}{
+		{"regulation runs out tied", comp.MatchTimer{Phase: "Regulation", ElapsedTicks: 1800}, 2, 2,
+			comp.MatchTimer{Phase: "Overtime", PhaseTicksLeft: OvertimeTicks, ElapsedTicks: 1800}, "", false},
+		{"overtime runs out tied", comp.MatchTimer{Phase: "Overtime", ElapsedTicks: 2400}, 1, 1,
+			comp.MatchTimer{Phase: "SuddenDeath", PhaseTicksLeft: SuddenDeathTicks, ElapsedTicks: 2400}, "", false},
+		{"sudden death still running", comp.MatchTimer{Phase: "SuddenDeath", PhaseTicksLeft: 10, ElapsedTicks: 2990}, 1, 1,
+			comp.MatchTimer{Phase: "SuddenDeath", PhaseTicksLeft: 10, ElapsedTicks: 2990}, "", false},
+		{"sudden death runs out tied", comp.MatchTimer{Phase: "SuddenDeath", ElapsedTicks: 3000}, 0, 0,
+			comp.MatchTimer{Phase: "SuddenDeath", ElapsedTicks: 3000}, "", true},
+		{"lead wins over moving on", comp.MatchTimer{Phase: "Regulation", ElapsedTicks: 1800}, 1, 2,
+			comp.MatchTimer{Phase: "Regulation", ElapsedTicks: 1800}, "Red", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timer := tt.timer
+			winner, tiebreak := settleMatchTimer(&timer, tt.blue, tt.red)
+			if winner != tt.wantWinner || tiebreak != tt.wantTiebreak {
+				t.Errorf("settleMatchTimer() = %q, %v, want %q, %v", winner, tiebreak, tt.wantWinner, tt.wantTiebreak)
+			}
+			if timer != tt.want {
+				t.Errorf("timer = %+v, want %+v", timer, tt.want)
+			}
+		})
+	}
+}
+
+func TestTiebreakEnding(t *testing.T) {
+	tests := []struct {
+		name      string
+		blue, red float32
+		want      matchEnding
+	}{
+		{"blue base healthier", 500, 200, matchEnding{"m", "Blue", "baseHealth"}},
+		{"red base healthier", 10, 11, matchEnding{"m", "Red", "baseHealth"}},
+		{"equal health is a draw", 300, 300, matchEnding{"m", "", "draw"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tiebreakEnding("m", tt.blue, tt.red); got != tt.want {
+				t.Errorf("tiebreakEnding(%v, %v) = %+v, want %+v", tt.blue, tt.red, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package system
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+)
+
+// prefix of the match ids matchmaking generates, create-match may not use it
+const QueueMatchIDPrefix = "mm-"
+
+// rating difference allowed between two queued players, it widens the longer a player waits
+var (
+	BaseRatingWindow   float64 = 100
+	RatingWindowGrowth float64 = 10 //per second (10 ticks) waited
+	MaxRatingWindow    float64 = 600
+)
+
+// rating difference the queue entry accepts after waiting since joinTick
+func RatingWindow(joinTick, currentTick uint64) float64 {
+	waited := float64(currentTick-joinTick) / 10
+	return math.Min(BaseRatingWindow+RatingWindowGrowth*waited, MaxRatingWindow)
+}
+
+// adds the sender to the matchmaking queue
+func JoinQueueSystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(world,
+		func(join cardinal.TxData[msg.JoinQueueMsg]) (msg.JoinQueueResult, error) {
+			//check the players deck before queueing them
+			if err := validateDeck(join.Msg.Deck); err != nil {
+				return msg.JoinQueueResult{Success: false}, fmt.Errorf("(matchmaking.go/JoinQueueSystem): %w", err)
+			}
+			if _, ok := MapDataRegistry[join.Msg.MapName]; !ok {
+				return msg.JoinQueueResult{Success: false}, fmt.Errorf("%w (matchmaking.go/JoinQueueSystem): %s", ErrUnknownMap, join.Msg.MapName)
+			}
+
+			entryID, err := getQueueEntry(world, join.Tx.PersonaTag)
+			if err != nil {
+				return msg.JoinQueueResult{Success: false}, fmt.Errorf("(matchmaking.go/JoinQueueSystem): %w", err)
+			}
+			if entryID != iterators.BadID {
+				return msg.JoinQueueResult{Success: false}, fmt.Errorf("%w (matchmaking.go/JoinQueueSystem): %s", ErrAlreadyQueued, join.Tx.PersonaTag)
+			}
+
+			//players finish (or surrender) their current match before queueing again
+			matchID, err := FindPlayerMatch(world, join.Tx.PersonaTag)
+			if err != nil {
+				return msg.JoinQueueResult{Success: false}, fmt.Errorf("(matchmaking.go/JoinQueueSystem): %w", err)
+			}
+			if matchID != "" {
+				return msg.JoinQueueResult{Success: false}, fmt.Errorf("%w (matchmaking.go/JoinQueueSystem): %s is playing %s", ErrAlreadyInMatch, join.Tx.PersonaTag, matchID)
+			}
+
+			rating, err := getPlayerRating(world, join.Tx.PersonaTag)
+			if err != nil {
+				return msg.JoinQueueResult{Success: false}, fmt.Errorf("(matchmaking.go/JoinQueueSystem): %w", err)
+			}
+
+			_, err = cardinal.Create(world, comp.QueueEntry{
+				PersonaTag: join.Tx.PersonaTag,
+				MapName:    join.Msg.MapName,
+				Deck:       join.Msg.Deck,
+				Rating:     rating,
+				JoinTick:   world.CurrentTick(),
+				TxHash:     string(join.Hash),
+			})
+			if err != nil {
+				return msg.JoinQueueResult{Success: false}, fmt.Errorf("error creating queue entry (matchmaking.go/JoinQueueSystem): %w", err)
+			}
+			return msg.JoinQueueResult{Success: true}, nil
+		})
+}
+
+// removes the sender from the matchmaking queue
+func LeaveQueueSystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(world,
+		func(leave cardinal.TxData[msg.LeaveQueueMsg]) (msg.LeaveQueueResult, error) {
+			entryID, err := getQueueEntry(world, leave.Tx.PersonaTag)
+			if err != nil {
+				return msg.LeaveQueueResult{Success: false}, fmt.Errorf("(matchmaking.go/LeaveQueueSystem): %w", err)
+			}
+			if entryID == iterators.BadID {
+				return msg.LeaveQueueResult{Success: false}, fmt.Errorf("%w (matchmaking.go/LeaveQueueSystem): %s", ErrNotQueued, leave.Tx.PersonaTag)
+			}
+			if err := cardinal.Remove(world, entryID); err != nil {
+				return msg.LeaveQueueResult{Success: false}, fmt.Errorf("error removing queue entry (matchmaking.go/LeaveQueueSystem): %w", err)
+			}
+			return msg.LeaveQueueResult{Success: true}, nil
+		})
+}
+
+type queuedPlayer struct {
+	id    types.EntityID
+	entry *comp.QueueEntry
+}
+
+// pairs queued players on the same map each tick, longest waiting first, with the closest rating inside both their windows
+func MatchmakingSystem(world cardinal.WorldContext) error {
+	var queue []queuedPlayer
+	err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.QueueEntry]())).
+		Each(world, func(id types.EntityID) bool {
+			entry, err := cardinal.GetComponent[comp.QueueEntry](world, id)
+			if err != nil {
+				fmt.Printf("error getting queue entry (matchmaking.go): %v \n", err)
+				return false
+			}
+			queue = append(queue, queuedPlayer{id: id, entry: entry})
+			return true
+		})
+	if err != nil {
+		return fmt.Errorf("error searching queue (matchmaking.go): %w", err)
+	}
+	if len(queue) < 2 {
+		return nil
+	}
+
+	//search order only depends on the queue, not entity ids
+	sort.Slice(queue, func(i, j int) bool {
+		if queue[i].entry.JoinTick != queue[j].entry.JoinTick {
+			return queue[i].entry.JoinTick < queue[j].entry.JoinTick
+		}
+		return queue[i].entry.PersonaTag < queue[j].entry.PersonaTag
+	})
+
+	tick := world.CurrentTick()
+	entries := make([]*comp.QueueEntry, len(queue))
+	for i, player := range queue {
+		entries[i] = player.entry
+	}
+	//a pair that fails to start stays queued and is paired again next tick
+	for i, pair := range pairQueue(entries, tick) {
+		matchID := fmt.Sprintf("%s%d-%d", QueueMatchIDPrefix, tick, i)
+		if err := startQueuedMatch(world, matchID, queue[pair[0]], queue[pair[1]]); err != nil {
+			fmt.Printf("error starting queued match %s (matchmaking.go): %v \n", matchID, err)
+		}
+	}
+	return nil
+}
+
+// pairs a queue sorted longest waiting first. each player in turn gets the closest rated unpaired player on the same
+// map whose rating is inside both players windows, a player who just joined is never paired outside their narrow
+// window because the other has waited long
+func pairQueue(queue []*comp.QueueEntry, tick uint64) [][2]int {
+	var pairs [][2]int
+	paired := make([]bool, len(queue))
+	for i, player := range queue {
+		if paired[i] {
+			continue
+		}
+		window := RatingWindow(player.JoinTick, tick)
+		best := -1
+		bestDiff := math.Inf(1)
+		for j := i + 1; j < len(queue); j++ {
+			opponent := queue[j]
+			if paired[j] || opponent.MapName != player.MapName {
+				continue
+			}
+			diff := math.Abs(player.Rating - opponent.Rating)
+			if diff <= math.Min(window, RatingWindow(opponent.JoinTick, tick)) && diff < bestDiff {
+				best, bestDiff = j, diff
+			}
+		}
+		if best == -1 {
+			continue
+		}
+		paired[i], paired[best] = true, true
+		pairs = append(pairs, [2]int{i, best})
+	}
+	return pairs
+}
+
+// creates the game state for a pair, the longer waiting player is player1, and takes both out of the queue
+func startQueuedMatch(world cardinal.WorldContext, matchID string, player1, player2 queuedPlayer) error {
+	mapName := player1.entry.MapName
+	gameState, err := createGameStateGSS(world, matchID, mapName, player1.entry.PersonaTag, player1.entry.Deck, types.TxHash(player1.entry.TxHash))
+	if err != nil {
+		return err
+	}
+	if err := addPlayer2GSS(world, gameState, matchID, mapName, player2.entry.PersonaTag, player2.entry.Deck, types.TxHash(player2.entry.TxHash)); err != nil {
+		//don't leave a half built match behind, both players stay queued
+		if removeErr := removeReplay(world, matchID); removeErr != nil {
+			fmt.Printf("error removing replay of half built match (matchmaking.go/startQueuedMatch): %v \n", removeErr)
+		}
+		if removeErr := RemoveAllEntitiesSystem(world, matchID); removeErr != nil {
+			fmt.Printf("error removing half built match (matchmaking.go/startQueuedMatch): %v \n", removeErr)
+		}
+		return err
+	}
+
+	if err := cardinal.Remove(world, player1.id); err != nil {
+		return fmt.Errorf("error removing queue entry (matchmaking.go/startQueuedMatch): %w", err)
+	}
+	if err := cardinal.Remove(world, player2.id); err != nil {
+		return fmt.Errorf("error removing queue entry (matchmaking.go/startQueuedMatch): %w", err)
+	}
+	return nil
+}
+
+// queue entry of a persona, iterators.BadID if it is not queued
+func getQueueEntry(world cardinal.WorldContext, personaTag string) (types.EntityID, error) {
+	queueFilter := cardinal.ComponentFilter(func(m comp.QueueEntry) bool {
+		return m.PersonaTag == personaTag
+	})
+	entryID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.QueueEntry]())).
+		Where(queueFilter).First(world)
+	if err != nil {
+		return entryID, fmt.Errorf("error searching queue (getQueueEntry): %w", err)
+	}
+	return entryID, nil
+}
+
+// errors if the persona is in the matchmaking queue, queued players can't create or join matches or matchmaking
+// would pair them into a second one
+func checkNotQueued(world cardinal.WorldContext, personaTag string) error {
+	entryID, err := getQueueEntry(world, personaTag)
+	if err != nil {
+		return fmt.Errorf("(checkNotQueued): %w", err)
+	}
+	if entryID != iterators.BadID {
+		return fmt.Errorf("%w (checkNotQueued): %s", ErrAlreadyQueued, personaTag)
+	}
+	return nil
+}
+
+// id of the match the persona is playing or waiting on, empty if none. ended matches don't count
+func FindPlayerMatch(world cardinal.WorldContext, personaTag string) (string, error) {
+	var matchID string
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.MatchId](), filter.Component[comp.Player1](), filter.Component[comp.MatchTimer]())).
+		Each(world, func(id types.EntityID) bool {
+			mID, p1, timer, err := GetComponents3[comp.MatchId, comp.Player1, comp.MatchTimer](world, id)
+			if err != nil {
+				fmt.Printf("error getting game state components (FindPlayerMatch): %v \n", err)
+				return false
+			}
+			if timer.Phase == "Ended" {
+				return true
+			}
+			playing := p1.Nickname == personaTag
+			if p2, err := cardinal.GetComponent[comp.Player2](world, id); err == nil && p2.Nickname == personaTag {
+				playing = true
+			}
+			if playing {
+				matchID = mID.MatchId
+				return false
+			}
+			return true
+		})
+	if err != nil {
+		return "", fmt.Errorf("error searching matches (FindPlayerMatch): %w", err)
+	}
+	return matchID, nil
+}
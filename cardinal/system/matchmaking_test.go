@@ -0,0 +1,67 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestRatingWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		joinTick uint64
+		tick     uint64
+		want     float64
+	}{
+		{"just joined", 50, 50, 100},
+		{"half a second", 0, 5, 105},
+		{"10 seconds", 20, 120, 200},
+		{"capped", 0, 10000, 600},
+		{"exactly at the cap", 0, 500, 600},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RatingWindow(tt.joinTick, tt.tick); got != tt.want {
+				t.Errorf("RatingWindow(%d, %d) = %v, want %v", tt.joinTick, tt.tick, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPairQueue(t *testing.T) {
+	entry := func(persona string, rating float64, joinTick uint64) *comp.QueueEntry {
+		return &comp.QueueEntry{PersonaTag: persona, MapName: "ProtoType", Rating: rating, JoinTick: joinTick}
+	}
+	tests := []struct {
+		name  string
+		queue []*comp.QueueEntry
+		tick  uint64
+		want  [][2]int
+	}{
+		{"equal ratings", []*comp.QueueEntry{entry("a", 1200, 0), entry("b", 1200, 0)}, 0, [][2]int{{0, 1}}},
+		{"alone", []*comp.QueueEntry{entry("a", 1200, 0)}, 0, nil},
+		{"other map", []*comp.QueueEntry{entry("a", 1200, 0), {PersonaTag: "b", MapName: "Other", Rating: 1200}}, 0, nil},
+		{"outside the window", []*comp.QueueEntry{entry("a", 1200, 0), entry("b", 1350, 0)}, 0, nil},
+		{"window grew", []*comp.QueueEntry{entry("a", 1200, 0), entry("b", 1350, 0)}, 50, [][2]int{{0, 1}}},
+		{"edge of the window", []*comp.QueueEntry{entry("a", 1200, 0), entry("b", 1300, 0)}, 0, [][2]int{{0, 1}}},
+		//the long waiter's window is 600 but the newcomer only accepts 100
+		{"newcomer's narrow window", []*comp.QueueEntry{entry("a", 1200, 0), entry("b", 1500, 5000)}, 5000, nil},
+		{"newcomer inside both windows", []*comp.QueueEntry{entry("a", 1200, 0), entry("b", 1290, 5000)}, 5000, [][2]int{{0, 1}}},
+		{"closest rating", []*comp.QueueEntry{entry("a", 1200, 0), entry("b", 1290, 1), entry("c", 1210, 2)}, 2, [][2]int{{0, 2}}},
+		{"longest waiting picks first", []*comp.QueueEntry{entry("a", 1200, 0), entry("b", 1250, 1), entry("c", 1260, 2)}, 2, [][2]int{{0, 1}}},
+		{
+			name:  "two pairs",
+			queue: []*comp.QueueEntry{entry("a", 1200, 0), entry("b", 1800, 1), entry("c", 1210, 2), entry("d", 1790, 3)},
+			tick:  3,
+			want:  [][2]int{{0, 2}, {1, 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pairQueue(tt.queue, tt.tick); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pairQueue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
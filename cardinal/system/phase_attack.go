@@ -15,10 +15,15 @@ func AttackPhaseSystem(world cardinal.WorldContext) error {
 	combatFilter := cardinal.ComponentFilter(func(m comp.Attack) bool {
 		return m.Combat
 	})
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
 	//for every object in combats id
-	err := cardinal.NewSearch().Entity(
+	err = cardinal.NewSearch().Entity(
 		filter.Contains(filter.Component[comp.Attack]())).
-		Where(combatFilter).Each(world, func(id types.EntityID) bool {
+		Where(cardinal.AndFilter(combatFilter, activeFilter)).Each(world, func(id types.EntityID) bool {
 
 		//get attack and class comps
 		atk, class, err := GetComponents2[comp.Attack, comp.Class](world, id)
@@ -62,13 +67,7 @@ func AttackPhaseSystem(world cardinal.WorldContext) error {
 // handles basic range / melee units in combat
 func MeleeRangeAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.Attack) error {
 
-	//get Unit CC component
-	cc, err := cardinal.GetComponent[comp.CC](world, id)
-	if err != nil {
-		fmt.Printf("error getting unit cc component (unit_movement.go): %v", err)
-	}
-
-	if cc.Stun > 0 { //if unit stunned cannot attack
+	if stunned(world, id) { //if unit stunned cannot attack
 		return nil
 	}
 
@@ -102,6 +101,12 @@ func MeleeRangeAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.
 			}
 		}
 
+		if unitSp.Charged {
+			if err := emitEntityEvent(world, id, "spTriggered"); err != nil {
+				return fmt.Errorf("(phase_Attack.go): %v", err)
+			}
+		}
+
 	} else if atk.Frame == 0 && unitSp.CurrentSp < unitSp.MaxSp { // in regular attack
 		unitSp.Charged = false
 	}
@@ -197,21 +202,10 @@ func StructureAttack(world cardinal.WorldContext, id types.EntityID, atk *comp.A
 
 // handles projectiles in combat (they are in range to deal dmg to enemy)
 func ProjectileAttack(world cardinal.WorldContext, id types.EntityID, projectileAttack *comp.Attack) error {
-	//get targets health compoenent from the projectiles attack target
-	enemyHealth, err := cardinal.GetComponent[comp.Health](world, projectileAttack.Target)
-	if err != nil {
-		return fmt.Errorf("error getting enemy Health component (projectile_Attack - phase_Attack.go): %v ", err)
-	}
-
 	//reduce enemy HP
-	enemyHealth.CurrentHP -= float32(projectileAttack.Damage)
-	if enemyHealth.CurrentHP < 0 {
-		enemyHealth.CurrentHP = 0
-	}
-	//set enemy HP compoenent
-	err = cardinal.SetComponent(world, projectileAttack.Target, enemyHealth)
+	_, err := resolveDamage(world, id, projectileAttack.Target, projectileAttack.Damage, projectileAttack.DamageType)
 	if err != nil {
-		return fmt.Errorf("error setting Health component (projectile_Attack - phase_Attack.go): %v ", err)
+		return fmt.Errorf("(projectile_Attack - phase_Attack.go): %v ", err)
 	}
 	//set projectime combat to false
 	projectileAttack.Combat = false
@@ -231,23 +225,3 @@ func ProjectileAttack(world cardinal.WorldContext, id types.EntityID, projectile
 	})
 	return nil
 }
-
-func applyDamage(world cardinal.WorldContext, id types.EntityID, damage float32) error {
-	// reduce health by units attack damage
-	err := cardinal.UpdateComponent(world, id, func(health *comp.Health) *comp.Health {
-		if health == nil {
-			fmt.Printf("error retrieving Health component (applyDamage - phase attack.go): ")
-			return nil
-		}
-		health.CurrentHP -= damage
-		if health.CurrentHP < 0 {
-			health.CurrentHP = 0 //never have negative health
-		}
-		return health
-	})
-	if err != nil {
-		return fmt.Errorf("error on attack (applyDamage - phase attack.go): %v", err)
-	}
-
-	return nil
-}
@@ -37,10 +37,16 @@ func unitCombatSearch(world cardinal.WorldContext) error {
 		return m.KnockBack
 	})
 
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
+
 	//for each unit not in combat
-	err := cardinal.NewSearch().Entity(
+	err = cardinal.NewSearch().Entity(
 		filter.Contains(filter.Component[comp.UnitTag]())).
-		Where(cardinal.OrFilter(combatFilter, ccFilter)).Each(world, func(id types.EntityID) bool {
+		Where(cardinal.AndFilter(cardinal.OrFilter(combatFilter, ccFilter), activeFilter)).Each(world, func(id types.EntityID) bool {
 
 		//get Unit CC component
 		cc, err := cardinal.GetComponent[comp.CC](world, id)
@@ -49,7 +55,7 @@ func unitCombatSearch(world cardinal.WorldContext) error {
 			return false
 		}
 
-		if cc.Stun > 0 { //if unit stunned cannot attack
+		if stunned(world, id) { //if unit stunned cannot attack
 			return true
 		}
 
@@ -149,9 +155,16 @@ func unitCombatSearch(world cardinal.WorldContext) error {
 
 // check if a structure not in combat can find a unit in range to attack
 func structureCombatSearch(world cardinal.WorldContext) error {
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
+
 	//for each structure not in combat
-	err := cardinal.NewSearch().Entity(
+	err = cardinal.NewSearch().Entity(
 		filter.Contains(filter.Component[comp.StructureTag]())).
+		Where(activeFilter).
 		Each(world, func(id types.EntityID) bool {
 			//get attack component
 			uAtk, err := cardinal.GetComponent[comp.Attack](world, id)
@@ -20,8 +20,14 @@ func DestroyerSystem(world cardinal.WorldContext) error {
 		return m.Destroyed
 	})
 
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
+
 	//for each unit with no hp's ids
-	err := cardinal.NewSearch().
+	err = cardinal.NewSearch().
 		Entity(
 			filter.Or(
 				filter.Contains(filter.Component[comp.UnitTag]()),
@@ -30,7 +36,7 @@ func DestroyerSystem(world cardinal.WorldContext) error {
 				filter.Contains(filter.Component[comp.SpEntity]()),
 			),
 		).
-		Where(cardinal.OrFilter(healthFilter, destroyedFilter)).
+		Where(cardinal.AndFilter(cardinal.OrFilter(healthFilter, destroyedFilter), activeFilter)).
 		Each(world, func(id types.EntityID) bool {
 
 			// get attack component
@@ -78,9 +84,9 @@ func DestroyerSystem(world cardinal.WorldContext) error {
 
 func unitDestroyerDefault(world cardinal.WorldContext, id types.EntityID) error {
 	//get needed compoenents
-	MatchID, uid, UnitPosition, UnitRadius, err := GetComponents4[comp.MatchId, comp.UID, comp.Position, comp.UnitRadius](world, id)
+	MatchID, UnitPosition, UnitRadius, err := GetComponents3[comp.MatchId, comp.Position, comp.UnitRadius](world, id)
 	if err != nil {
-		return fmt.Errorf("4 (unit_destroyer): %v ", err)
+		return fmt.Errorf("3 (unit_destroyer): %v ", err)
 	}
 
 	//get game state
@@ -88,11 +94,6 @@ func unitDestroyerDefault(world cardinal.WorldContext, id types.EntityID) error
 	if err != nil {
 		return fmt.Errorf("(unit_destroyer) %v ", err)
 	}
-	//get player components
-	p1, p2, err := getPlayerComponentsGSS(world, gameState)
-	if err != nil {
-		return fmt.Errorf("(unit_destroyer) %v ", err)
-	}
 
 	//filter for units targeting self
 	targetFilter := cardinal.ComponentFilter(func(m comp.Attack) bool {
@@ -130,7 +131,7 @@ func unitDestroyerDefault(world cardinal.WorldContext, id types.EntityID) error
 	projectileFilter := cardinal.AndFilter(targetFilter, destroyedFilter)
 
 	//for projectiles targetting self destroy
-	err = destroyProjectilesTargetingSelfUD(world, projectileFilter, p1, p2)
+	err = destroyProjectilesTargetingSelfUD(world, projectileFilter)
 	if err != nil {
 		return fmt.Errorf("(unit_destroyer) %v ", err)
 	}
@@ -151,6 +152,11 @@ func unitDestroyerDefault(world cardinal.WorldContext, id types.EntityID) error
 		return fmt.Errorf("error retrieving SpartialHash component on tempSpartialHash (unit_destroyer): %s ", err)
 	}
 
+	//tell clients before the entity is gone
+	if err := emitEntityEvent(world, id, "destroyed"); err != nil {
+		return fmt.Errorf("(unit_destroyer): %v", err)
+	}
+
 	//remove entity
 	if err := cardinal.Remove(world, id); err != nil {
 		return fmt.Errorf("error removing entity (unit_destroyer): %v", err) // Log error if any
@@ -158,11 +164,8 @@ func unitDestroyerDefault(world cardinal.WorldContext, id types.EntityID) error
 
 	RemoveObjectFromSpatialHash(CollisionSpartialHash, id, UnitPosition.PositionVectorX, UnitPosition.PositionVectorY, UnitRadius.UnitRadius)
 
-	p1.RemovalList[uid.UID] = true //add removed units to players removal list
-	p2.RemovalList[uid.UID] = true
-
-	//set collision hash, player1 and player2
-	if err = SetComponents3(world, gameState, CollisionSpartialHash, p1, p2); err != nil {
+	//set collision hash
+	if err = cardinal.SetComponent(world, gameState, CollisionSpartialHash); err != nil {
 		return fmt.Errorf("(unit_destroyer): %v", err)
 	}
 
@@ -181,11 +184,6 @@ func structureDestroyerDefault(world cardinal.WorldContext, id types.EntityID) e
 	if err != nil {
 		return fmt.Errorf("(tower destroyer.go) %v", err)
 	}
-	//get player components
-	p1, p2, err := getPlayerComponentsGSS(world, gameState)
-	if err != nil {
-		return fmt.Errorf("(tower destroyer.go) %v", err)
-	}
 
 	//filter for units targeting self
 	targetFilter := cardinal.ComponentFilter(func(m comp.Attack) bool {
@@ -206,7 +204,7 @@ func structureDestroyerDefault(world cardinal.WorldContext, id types.EntityID) e
 	projectileFilter := cardinal.AndFilter(targetFilter, destroyedFilter)
 
 	//for projectiles targetting self destroy
-	err = destroyProjectilesTargetingSelfUD(world, projectileFilter, p1, p2)
+	err = destroyProjectilesTargetingSelfUD(world, projectileFilter)
 	if err != nil {
 		return fmt.Errorf("(tower destroyer.go) %v", err)
 	}
@@ -247,6 +245,24 @@ func structureDestroyerDefault(world cardinal.WorldContext, id types.EntityID) e
 			return fmt.Errorf("(tower destroyer.go): %v", err)
 		}
 
+		//credit the team that took the tower
+		err = cardinal.UpdateComponent(world, gameState, func(stats *comp.MatchStats) *comp.MatchStats {
+			if stats == nil {
+				fmt.Printf("error retrieving match stats component (tower destroyer.go): \n")
+				return nil
+			}
+			stats.TowersDestroyed[team.Team]++
+			return stats
+		})
+		if err != nil {
+			return fmt.Errorf("error updating match stats (tower destroyer.go): %v", err)
+		}
+
+		if err := emitEntityEvent(world, id, "towerConverted"); err != nil {
+			return fmt.Errorf("(tower destroyer.go): %v", err)
+		}
+	} else if err := emitEntityEvent(world, id, "baseDestroyed"); err != nil {
+		return fmt.Errorf("(tower destroyer.go): %v", err)
 	}
 
 	//set combat to false
@@ -262,8 +278,8 @@ func structureDestroyerDefault(world cardinal.WorldContext, id types.EntityID) e
 		return fmt.Errorf("error on vampire attack (tower destroyer.go): %v", err)
 	}
 
-	//set collision hash, player1 and player2
-	if err = SetComponents3(world, gameState, CollisionSpartialHash, p1, p2); err != nil {
+	//set collision hash
+	if err = cardinal.SetComponent(world, gameState, CollisionSpartialHash); err != nil {
 		return fmt.Errorf("(tower destroyer.go): %v", err)
 	}
 
@@ -272,40 +288,11 @@ func structureDestroyerDefault(world cardinal.WorldContext, id types.EntityID) e
 
 func projectileDestroyerDefault(world cardinal.WorldContext, id types.EntityID) error {
 
-	//get matchid and uid of projectile
-	MatchID, uid, err := GetComponents2[comp.MatchId, comp.UID](world, id)
-	if err != nil {
-		return fmt.Errorf("get projectile components (projectile_destroyer): %v", err)
-	}
-
-	//get game state
-	gameState, err := getGameStateGSS(world, MatchID)
-	if err != nil {
-		return fmt.Errorf("(projectile_destroyer.go) - %v", err)
+	//tell clients before the entity is gone
+	if err := emitEntityEvent(world, id, "destroyed"); err != nil {
+		return fmt.Errorf("(projectile_destroyer): %v", err)
 	}
 
-	//add projectile id to player1 removal list
-	cardinal.UpdateComponent(world, gameState, func(player1 *comp.Player1) *comp.Player1 {
-		if player1 == nil {
-			fmt.Printf("error retrieving player1 component (projectile_destroyer)")
-			return nil
-		}
-		//player1.RemovalList = append(player1.RemovalList, uid.UID)
-		player1.RemovalList[uid.UID] = true
-		return player1
-	})
-
-	//add projectile id to player2 removal list
-	cardinal.UpdateComponent(world, gameState, func(player2 *comp.Player2) *comp.Player2 {
-		if player2 == nil {
-			fmt.Printf("error retrieving player2 component (projectile_destroyer)")
-			return nil
-		}
-		//player1.RemovalList = append(player1.RemovalList, uid.UID)
-		player2.RemovalList[uid.UID] = true
-		return player2
-	})
-
 	//remove projectile
 	if err := cardinal.Remove(world, id); err != nil {
 		return fmt.Errorf("error removing entity (projectile_destroyer): %v", err)
@@ -422,22 +409,18 @@ func resetStructuresTargetingSelfUD(world cardinal.WorldContext, targetFilter ca
 }
 
 // for each projectile targeting targetFilter, destroy
-func destroyProjectilesTargetingSelfUD(world cardinal.WorldContext, targetFilter cardinal.FilterFn, p1 *comp.Player1, p2 *comp.Player2) error {
+func destroyProjectilesTargetingSelfUD(world cardinal.WorldContext, targetFilter cardinal.FilterFn) error {
 	//for each targetting projectile
 	err := cardinal.NewSearch().Entity(
 		filter.Contains(filter.Component[comp.ProjectileTag]())).
 		Where(targetFilter).Each(world, func(projectileID types.EntityID) bool {
 
-		//get projectile uid
-		projectileUID, err := cardinal.GetComponent[comp.UID](world, projectileID)
-		if err != nil {
-			fmt.Printf("error retrieving projectile UID component (destroyProjectilesTargetingSelfUD): %s \n", err)
+		//tell clients before the entity is gone
+		if err := emitEntityEvent(world, projectileID, "destroyed"); err != nil {
+			fmt.Printf("(destroyProjectilesTargetingSelfUD): %s \n", err)
 			return false
 		}
 
-		p1.RemovalList[projectileUID.UID] = true // add to players removal lists
-		p2.RemovalList[projectileUID.UID] = true
-
 		//remove entity
 		if err := cardinal.Remove(world, projectileID); err != nil {
 			fmt.Println("Error removing entity projectile (destroyProjectilesTargetingSelfUD):", err)
@@ -0,0 +1,150 @@
+package system
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// rating a persona starts with
+const DefaultRating float64 = 1200
+
+// elo K factor, how far a single result moves a rating
+var EloKFactor float64 = 32
+
+// expected score of a player rated rating against an opponent rated opponentRating
+func eloExpected(rating, opponentRating float64) float64 {
+	return 1 / (1 + math.Pow(10, (opponentRating-rating)/400))
+}
+
+// rating change for scoring score (1 win, 0 loss, .5 draw) against an opponent rated opponentRating
+func eloChange(rating, opponentRating, score float64) float64 {
+	return EloKFactor * (score - eloExpected(rating, opponentRating))
+}
+
+// rating matchmaking uses for a persona, DefaultRating until it has played a match
+func getPlayerRating(world cardinal.WorldContext, personaTag string) (float64, error) {
+	_, profile, err := getPlayerProfile(world, personaTag)
+	if err != nil {
+		return 0, err
+	}
+	if profile == nil {
+		return DefaultRating, nil
+	}
+	return profile.Rating, nil
+}
+
+// profile entity of a persona, nil profile if it has never finished a match
+func getPlayerProfile(world cardinal.WorldContext, personaTag string) (types.EntityID, *comp.PlayerProfile, error) {
+	profileFilter := cardinal.ComponentFilter(func(m comp.PlayerProfile) bool {
+		return m.PersonaTag == personaTag
+	})
+	profileID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.PlayerProfile]())).
+		Where(profileFilter).First(world)
+	if err != nil {
+		return profileID, nil, fmt.Errorf("error searching for player profile (getPlayerProfile): %w", err)
+	}
+	if profileID == iterators.BadID {
+		return profileID, nil, nil
+	}
+
+	profile, err := cardinal.GetComponent[comp.PlayerProfile](world, profileID)
+	if err != nil {
+		return profileID, nil, fmt.Errorf("error getting player profile component (getPlayerProfile): %w", err)
+	}
+	return profileID, profile, nil
+}
+
+// applies a finished match to both players profiles, creating them on a players first match.
+// only matchmade matches are rated, aborted ones are not
+func updatePlayerProfiles(world cardinal.WorldContext, result *comp.MatchResult) error {
+	//players pick their opponent in create-match games, two personas could farm rating by one surrendering.
+	//practice matches against a bot are unrated too
+	if !strings.HasPrefix(result.MatchId, QueueMatchIDPrefix) || result.Reason == "aborted" || isBot(result.BluePlayer) || isBot(result.RedPlayer) {
+		return nil
+	}
+
+	blueID, blue, err := getPlayerProfile(world, result.BluePlayer)
+	if err != nil {
+		return err
+	}
+	redID, red, err := getPlayerProfile(world, result.RedPlayer)
+	if err != nil {
+		return err
+	}
+	if blue == nil {
+		blue = &comp.PlayerProfile{PersonaTag: result.BluePlayer, Rating: DefaultRating}
+	}
+	if red == nil {
+		red = &comp.PlayerProfile{PersonaTag: result.RedPlayer, Rating: DefaultRating}
+	}
+
+	//1 for a win, 0 for a loss, .5 for a draw
+	blueScore := 0.5
+	switch result.WinningTeam {
+	case "Blue":
+		blueScore = 1
+	case "Red":
+		blueScore = 0
+	}
+
+	//both changes come from the ratings before the match
+	blueChange := eloChange(blue.Rating, red.Rating, blueScore)
+	applyMatchToProfile(blue, blueScore, blueChange, result.MatchId)
+	applyMatchToProfile(red, 1-blueScore, -blueChange, result.MatchId)
+
+	if err := setPlayerProfile(world, blueID, blue); err != nil {
+		return err
+	}
+	return setPlayerProfile(world, redID, red)
+}
+
+// records one result on a profile
+func applyMatchToProfile(profile *comp.PlayerProfile, score, ratingChange float64, matchID string) {
+	profile.Rating += ratingChange
+	profile.GamesPlayed++
+	profile.LastMatchId = matchID
+
+	switch score {
+	case 1:
+		profile.Wins++
+		if profile.Streak < 0 {
+			profile.Streak = 0
+		}
+		profile.Streak++
+	case 0:
+		profile.Losses++
+		if profile.Streak > 0 {
+			profile.Streak = 0
+		}
+		profile.Streak--
+	default:
+		profile.Draws++
+		profile.Streak = 0
+	}
+	if profile.Streak > profile.BestStreak {
+		profile.BestStreak = profile.Streak
+	}
+}
+
+// saves a profile, creating its entity if profileID is iterators.BadID
+func setPlayerProfile(world cardinal.WorldContext, profileID types.EntityID, profile *comp.PlayerProfile) error {
+	if profileID == iterators.BadID {
+		if _, err := cardinal.Create(world, *profile); err != nil {
+			return fmt.Errorf("error creating player profile (setPlayerProfile): %w", err)
+		}
+		return nil
+	}
+	if err := cardinal.SetComponent(world, profileID, profile); err != nil {
+		return fmt.Errorf("error setting player profile (setPlayerProfile): %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package system
+
+import (
+	"math"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestEloExpected(t *testing.T) {
+	tests := []struct {
+		name     string
+		rating   float64
+		opponent float64
+		want     float64
+	}{
+		{"equal ratings", 1200, 1200, .5},
+		{"400 above", 1600, 1200, 10.0 / 11},
+		{"400 below", 1200, 1600, 1.0 / 11},
+		{"800 above", 2000, 1200, 100.0 / 101},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := eloExpected(tt.rating, tt.opponent); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("eloExpected(%v, %v) = %v, want %v", tt.rating, tt.opponent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEloChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		rating   float64
+		opponent float64
+		score    float64
+		want     float64
+	}{
+		{"equal win", 1200, 1200, 1, 16},
+		{"equal loss", 1200, 1200, 0, -16},
+		{"equal draw", 1200, 1200, .5, 0},
+		{"favourite wins", 1600, 1200, 1, 32.0 / 11},
+		{"favourite loses", 1600, 1200, 0, -320.0 / 11},
+		{"underdog wins", 1200, 1600, 1, 320.0 / 11},
+		{"underdog draws", 1200, 1600, .5, 32 * (.5 - 1.0/11)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := eloChange(tt.rating, tt.opponent, tt.score)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("eloChange(%v, %v, %v) = %v, want %v", tt.rating, tt.opponent, tt.score, got, tt.want)
+			}
+			//elo is zero sum, the opponent moves the other way by the same amount
+			if other := eloChange(tt.opponent, tt.rating, 1-tt.score); math.Abs(got+other) > 1e-9 {
+				t.Errorf("opponent change %v, want %v", other, -got)
+			}
+		})
+	}
+}
+
+func TestApplyMatchToProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile comp.PlayerProfile
+		score   float64
+		change  float64
+		want    comp.PlayerProfile
+	}{
+		{
+			name:    "first win",
+			profile: comp.PlayerProfile{Rating: DefaultRating},
+			score:   1,
+			change:  16,
+			want:    comp.PlayerProfile{Rating: 1216, GamesPlayed: 1, Wins: 1, Streak: 1, BestStreak: 1, LastMatchId: "m"},
+		},
+		{
+			name:    "win extends streak",
+			profile: comp.PlayerProfile{Rating: 1300, GamesPlayed: 4, Wins: 4, Streak: 4, BestStreak: 4},
+			score:   1,
+			change:  10,
+			want:    comp.PlayerProfile{Rating: 1310, GamesPlayed: 5, Wins: 5, Streak: 5, BestStreak: 5, LastMatchId: "m"},
+		},
+		{
+			name:    "win ends losing streak",
+			profile: comp.PlayerProfile{Rating: 1100, GamesPlayed: 3, Losses: 3, Streak: -3},
+			score:   1,
+			change:  20,
+			want:    comp.PlayerProfile{Rating: 1120, GamesPlayed: 4, Wins: 1, Losses: 3, Streak: 1, BestStreak: 1, LastMatchId: "m"},
+		},
+		{
+			name:    "loss ends winning streak and keeps best",
+			profile: comp.PlayerProfile{Rating: 1300, GamesPlayed: 3, Wins: 3, Streak: 3, BestStreak: 3},
+			score:   0,
+			change:  -16,
+			want:    comp.PlayerProfile{Rating: 1284, GamesPlayed: 4, Wins: 3, Losses: 1, Streak: -1, BestStreak: 3, LastMatchId: "m"},
+		},
+		{
+			name:    "loss extends losing streak",
+			profile: comp.PlayerProfile{Rating: 1100, GamesPlayed: 2, Losses: 2, Streak: -2},
+			score:   0,
+			change:  -12,
+			want:    comp.PlayerProfile{Rating: 1088, GamesPlayed: 3, Losses: 3, Streak: -3, LastMatchId: "m"},
+		},
+		{
+			name:    "draw resets streak",
+			profile: comp.PlayerProfile{Rating: 1200, GamesPlayed: 2, Wins: 2, Streak: 2, BestStreak: 2},
+			score:   .5,
+			change:  0,
+			want:    comp.PlayerProfile{Rating: 1200, GamesPlayed: 3, Wins: 2, Draws: 1, BestStreak: 2, LastMatchId: "m"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := tt.profile
+			applyMatchToProfile(&profile, tt.score, tt.change, "m")
+			if profile != tt.want {
+				t.Errorf("applyMatchToProfile() = %+v, want %+v", profile, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package system
+
+import (
+	"fmt"
+	"strings"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+)
+
+// starts a match against a server side bot, the sender is player1 and the bot fills player2 with the same deck
+func (r Rules) PracticeMatchSystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(world,
+		func(create cardinal.TxData[msg.CreatePracticeMatchMsg]) (msg.CreatePracticeMatchResult, error) {
+			if err := validateDeck(create.Msg.Deck); err != nil {
+				return msg.CreatePracticeMatchResult{Success: false}, fmt.Errorf("(practice_match.go): %w", err)
+			}
+			if _, ok := BotDifficulties[create.Msg.Difficulty]; !ok {
+				return msg.CreatePracticeMatchResult{Success: false}, fmt.Errorf("%w (practice_match.go): %s", ErrUnknownBot, create.Msg.Difficulty)
+			}
+			if _, ok := MapDataRegistry[create.Msg.MapName]; !ok {
+				return msg.CreatePracticeMatchResult{Success: false}, fmt.Errorf("%w (practice_match.go): %s", ErrUnknownMap, create.Msg.MapName)
+			}
+			if !r.replaying() && strings.HasPrefix(create.Msg.MatchID, QueueMatchIDPrefix) {
+				return msg.CreatePracticeMatchResult{Success: false}, fmt.Errorf("%w (practice_match.go): %s", ErrReservedID, create.Msg.MatchID)
+			}
+			if err := checkNotQueued(world, create.Tx.PersonaTag); err != nil {
+				return msg.CreatePracticeMatchResult{Success: false}, fmt.Errorf("(practice_match.go): %w", err)
+			}
+
+			// match ids are single use, running or finished
+			if _, _, err := getMatchResult(world, create.Msg.MatchID); err == nil {
+				return msg.CreatePracticeMatchResult{Success: false}, fmt.Errorf("%w, match ids are single use (practice_match.go): %s", ErrMatchEnded, create.Msg.MatchID)
+			}
+			matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+				return m.MatchId == create.Msg.MatchID
+			})
+			count, err := cardinal.NewSearch().Entity(
+				filter.Contains(filter.Component[comp.MatchId](), filter.Component[comp.Player1]())).
+				Where(matchFilter).Count(world)
+			if err != nil {
+				return msg.CreatePracticeMatchResult{Success: false}, fmt.Errorf("error during search (practice_match.go): %w", err)
+			}
+			if count > 0 {
+				return msg.CreatePracticeMatchResult{Success: false}, fmt.Errorf("%w (practice_match.go): %s", ErrMatchExists, create.Msg.MatchID)
+			}
+
+			txHash := r.recordedTxHash(create.Hash)
+			gameState, err := createGameStateGSS(world, create.Msg.MatchID, create.Msg.MapName, create.Tx.PersonaTag, create.Msg.Deck, txHash)
+			if err != nil {
+				return msg.CreatePracticeMatchResult{Success: false}, err
+			}
+			botDeck := append([]string(nil), create.Msg.Deck...)
+			err = addPlayer2GSS(world, gameState, create.Msg.MatchID, create.Msg.MapName, BotNicknamePrefix+create.Msg.Difficulty, botDeck, txHash)
+			if err != nil {
+				return msg.CreatePracticeMatchResult{Success: false}, err
+			}
+			if err := spawnBot(world, gameState, create.Msg.MatchID, "Red", create.Msg.Difficulty); err != nil {
+				return msg.CreatePracticeMatchResult{Success: false}, err
+			}
+
+			//the whole match comes from this one message, the bot replays its choices from the seed
+			err = restartReplay(world, create.Msg.MatchID, "create-practice-match", create.Tx.PersonaTag, txHash, create.Msg)
+			if err != nil {
+				return msg.CreatePracticeMatchResult{Success: false}, err
+			}
+			return msg.CreatePracticeMatchResult{Success: true}, nil
+		})
+}
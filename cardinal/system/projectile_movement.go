@@ -15,10 +15,15 @@ func ProjectileMovementSystem(world cardinal.WorldContext) error {
 	classFilter := cardinal.ComponentFilter(func(m comp.Class) bool {
 		return m.Class == "projectile"
 	})
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
 	//for each projectile id
-	err := cardinal.NewSearch().Entity(
+	err = cardinal.NewSearch().Entity(
 		filter.Contains(filter.Component[comp.ProjectileTag]())).
-		Where(classFilter).Each(world, func(projectileID types.EntityID) bool {
+		Where(cardinal.AndFilter(classFilter, activeFilter)).Each(world, func(projectileID types.EntityID) bool {
 		//get needed projectile components
 		projectileAtk, projectileMs, projectilePos, err := GetComponents3[comp.Attack, comp.Movespeed, comp.Position](world, projectileID)
 		if err != nil {
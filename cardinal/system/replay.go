@@ -0,0 +1,249 @@
+package system
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+)
+
+// starts recording a match with the create-match that made its game state
+func createReplay(world cardinal.WorldContext, matchID, mapName, personaTag string, cards []string, txHash types.TxHash) error {
+	input, err := newReplayInput(world, "create-match", personaTag, txHash, msg.CreateMatchMsg{MatchID: matchID, MapName: mapName, Deck: cards})
+	if err != nil {
+		return err
+	}
+	_, err = cardinal.Create(world, comp.Replay{
+		MatchId:         matchID,
+		MapName:         mapName,
+		BalanceVersion:  BalanceVersion,
+		BalanceChecksum: BalanceChecksum,
+		Inputs:          []comp.ReplayInput{input},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating replay (replay.go/createReplay): %w", err)
+	}
+	return nil
+}
+
+// records player2 joining, the match starts on this tick with its final seed
+func startReplay(world cardinal.WorldContext, matchID, mapName, personaTag string, cards []string, txHash types.TxHash, seed uint64) error {
+	input, err := newReplayInput(world, "create-match", personaTag, txHash, msg.CreateMatchMsg{MatchID: matchID, MapName: mapName, Deck: cards})
+	if err != nil {
+		return err
+	}
+	return updateReplay(world, matchID, func(replay *comp.Replay) {
+		replay.Inputs = append(replay.Inputs, input)
+		replay.StartTick = world.CurrentTick()
+		replay.Seed = seed
+	})
+}
+
+// appends a message the match accepted to its replay, txHash is the hash the message was recorded with
+func recordReplayInput(world cardinal.WorldContext, matchID, message, personaTag string, txHash types.TxHash, body any) error {
+	input, err := newReplayInput(world, message, personaTag, txHash, body)
+	if err != nil {
+		return err
+	}
+	return updateReplay(world, matchID, func(replay *comp.Replay) {
+		replay.Inputs = append(replay.Inputs, input)
+	})
+}
+
+// replaces the recorded inputs with the single message that created and started the match (create-practice-match)
+func restartReplay(world cardinal.WorldContext, matchID, message, personaTag string, txHash types.TxHash, body any) error {
+	input, err := newReplayInput(world, message, personaTag, txHash, body)
+	if err != nil {
+		return err
+	}
+	return updateReplay(world, matchID, func(replay *comp.Replay) {
+		replay.Inputs = []comp.ReplayInput{input}
+	})
+}
+
+// stamps the end tick and final state hash on a replay, called before the ended match is frozen
+func finishReplay(world cardinal.WorldContext, matchID string) error {
+	stateHash, err := MatchStateHash(world, matchID)
+	if err != nil {
+		return err
+	}
+	return updateReplay(world, matchID, func(replay *comp.Replay) {
+		replay.EndTick = world.CurrentTick()
+		replay.StateHash = stateHash
+	})
+}
+
+// removes the replay of a match that never started
+func removeReplay(world cardinal.WorldContext, matchID string) error {
+	replayID, _, err := getReplay(world, matchID)
+	if err != nil {
+		return err
+	}
+	if replayID == iterators.BadID {
+		return nil
+	}
+	if err := cardinal.Remove(world, replayID); err != nil {
+		return fmt.Errorf("error removing replay (replay.go/removeReplay): %w", err)
+	}
+	return nil
+}
+
+func newReplayInput(world cardinal.WorldContext, message, personaTag string, txHash types.TxHash, body any) (comp.ReplayInput, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return comp.ReplayInput{}, fmt.Errorf("error encoding %s for replay (replay.go): %w", message, err)
+	}
+	return comp.ReplayInput{
+		Tick:       world.CurrentTick(),
+		Message:    message,
+		PersonaTag: personaTag,
+		TxHash:     string(txHash),
+		Body:       raw,
+	}, nil
+}
+
+// applies update to the replay of a match. matches created before replays were recorded have none and are skipped
+func updateReplay(world cardinal.WorldContext, matchID string, update func(replay *comp.Replay)) error {
+	replayID, replay, err := getReplay(world, matchID)
+	if err != nil {
+		return err
+	}
+	if replayID == iterators.BadID {
+		return nil
+	}
+	update(replay)
+	if err := cardinal.SetComponent(world, replayID, replay); err != nil {
+		return fmt.Errorf("error setting replay (replay.go/updateReplay): %w", err)
+	}
+	return nil
+}
+
+// replay entity of a match, iterators.BadID if it has none
+func getReplay(world cardinal.WorldContext, matchID string) (types.EntityID, *comp.Replay, error) {
+	replayFilter := cardinal.ComponentFilter(func(m comp.Replay) bool {
+		return m.MatchId == matchID
+	})
+	replayID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.Replay]())).
+		Where(replayFilter).First(world)
+	if err != nil {
+		return replayID, nil, fmt.Errorf("error searching for replay (getReplay): %w", err)
+	}
+	if replayID == iterators.BadID {
+		return replayID, nil, nil
+	}
+	replay, err := cardinal.GetComponent[comp.Replay](world, replayID)
+	if err != nil {
+		return replayID, nil, fmt.Errorf("error getting replay component (getReplay): %w", err)
+	}
+	return replayID, replay, nil
+}
+
+// gameplay state of one entity that goes into the match state hash
+type entityState struct {
+	UID      int
+	UnitName string
+	Team     string
+	Position *comp.Position `json:",omitempty"`
+	Health   *comp.Health   `json:",omitempty"`
+}
+
+// player state that goes into the match state hash
+type playerState struct {
+	Nickname string
+	Hand     []string
+	Deck     []string
+	Gold     float32
+}
+
+// sha256 over the gameplay state of a match: both players, the clock, the stats and every unit, structure and projectile.
+// a re-simulated match has to end on the same hash as the recorded one
+func MatchStateHash(world cardinal.WorldContext, matchID string) (string, error) {
+	gameState, err := getGameStateGSS(world, &comp.MatchId{MatchId: matchID})
+	if err != nil {
+		return "", fmt.Errorf("(MatchStateHash): %w", err)
+	}
+	p1, p2, err := getPlayerComponentsGSS(world, gameState)
+	if err != nil {
+		return "", fmt.Errorf("(MatchStateHash): %w", err)
+	}
+	timer, stats, err := GetComponents2[comp.MatchTimer, comp.MatchStats](world, gameState)
+	if err != nil {
+		return "", fmt.Errorf("(MatchStateHash): %w", err)
+	}
+
+	var entities []entityState
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == matchID
+	})
+	err = cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.MatchId](), filter.Component[comp.UID]())).
+		Where(matchFilter).
+		Each(world, func(id types.EntityID) bool {
+			if id == gameState {
+				return true
+			}
+			uid, err := cardinal.GetComponent[comp.UID](world, id)
+			if err != nil {
+				fmt.Printf("error getting uid (MatchStateHash): %v \n", err)
+				return false
+			}
+			state := entityState{UID: uid.UID}
+			if name, err := cardinal.GetComponent[comp.UnitName](world, id); err == nil {
+				state.UnitName = name.UnitName
+			}
+			if team, err := cardinal.GetComponent[comp.Team](world, id); err == nil {
+				state.Team = team.Team
+			}
+			if pos, err := cardinal.GetComponent[comp.Position](world, id); err == nil {
+				state.Position = pos
+			}
+			if health, err := cardinal.GetComponent[comp.Health](world, id); err == nil {
+				state.Health = health
+			}
+			entities = append(entities, state)
+			return true
+		})
+	if err != nil {
+		return "", fmt.Errorf("error searching match entities (MatchStateHash): %w", err)
+	}
+	return hashMatchState(
+		playerState{Nickname: p1.Nickname, Hand: p1.Hand, Deck: p1.Deck, Gold: p1.Gold},
+		playerState{Nickname: p2.Nickname, Hand: p2.Hand, Deck: p2.Deck, Gold: p2.Gold},
+		timer, stats, entities)
+}
+
+// sha256 of the encoded match state, entities are ordered by uid first so the search order doesn't matter
+func hashMatchState(p1, p2 playerState, timer *comp.MatchTimer, stats *comp.MatchStats, entities []entityState) (string, error) {
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].UID < entities[j].UID
+	})
+
+	raw, err := json.Marshal(struct {
+		Player1  playerState
+		Player2  playerState
+		Timer    *comp.MatchTimer
+		Stats    *comp.MatchStats
+		Entities []entityState
+	}{
+		Player1:  p1,
+		Player2:  p2,
+		Timer:    timer,
+		Stats:    stats,
+		Entities: entities,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error encoding match state (MatchStateHash): %w", err)
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,88 @@
+package system
+
+import (
+	"testing"
+
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestHashMatchState(t *testing.T) {
+	p1 := playerState{Nickname: "alice", Hand: []string{"Mage"}, Deck: []string{"Vampire"}, Gold: 4}
+	p2 := playerState{Nickname: "bob", Hand: []string{"LeafBird"}, Deck: []string{"LavaGolem"}, Gold: 2}
+	timer := &comp.MatchTimer{Phase: "Regulation", PhaseTicksLeft: 100, ElapsedTicks: 1700}
+	stats := newMatchStats()
+	entities := func() []entityState {
+		return []entityState{
+			{UID: 1, UnitName: "Mage", Team: "Blue", Position: &comp.Position{PositionVectorX: 10}, Health: &comp.Health{CurrentHP: 50}},
+			{UID: 2, UnitName: "Tower", Team: "Red", Health: &comp.Health{CurrentHP: 900}},
+			{UID: 3, UnitName: "Arrow", Team: "Red", Position: &comp.Position{PositionVectorY: 40}},
+		}
+	}
+	want, err := hashMatchState(p1, p2, timer, &stats, entities())
+	if err != nil {
+		t.Fatalf("hashMatchState() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		p1       playerState
+		timer    *comp.MatchTimer
+		entities func() []entityState
+		same     bool
+	}{
+		{"same state", p1, timer, entities, true},
+		{"entities found in another order", p1, timer, func() []entityState {
+			e := entities()
+			e[0], e[2] = e[2], e[0]
+			return e
+		}, true},
+		{"unit moved", p1, timer, func() []entityState {
+			e := entities()
+			e[0].Position = &comp.Position{PositionVectorX: 11}
+			return e
+		}, false},
+		{"unit lost health", p1, timer, func() []entityState {
+			e := entities()
+			e[1].Health = &comp.Health{CurrentHP: 899}
+			return e
+		}, false},
+		{"unit died", p1, timer, func() []entityState { return entities()[1:] }, false},
+		{"player gold", playerState{Nickname: "alice", Hand: []string{"Mage"}, Deck: []string{"Vampire"}, Gold: 5}, timer, entities, false},
+		{"clock", p1, &comp.MatchTimer{Phase: "Regulation", PhaseTicksLeft: 99, ElapsedTicks: 1701}, entities, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := hashMatchState(tt.p1, p2, tt.timer, &stats, tt.entities())
+			if err != nil {
+				t.Fatalf("hashMatchState() error = %v", err)
+			}
+			if (got == want) != tt.same {
+				t.Errorf("hashMatchState() = %s, recorded %s, want same %v", got, want, tt.same)
+			}
+		})
+	}
+}
+
+func TestRecordedTxHash(t *testing.T) {
+	session := NewReplaySession()
+	session.Record("replayed", "recorded")
+	tests := []struct {
+		name   string
+		rules  Rules
+		txHash types.TxHash
+		want   types.TxHash
+	}{
+		{"live shard keeps its hash", Rules{}, "replayed", "replayed"},
+		{"replay uses the recorded hash", Rules{Replay: session}, "replayed", "recorded"},
+		{"unrecorded transaction in a replay", Rules{Replay: session}, "other", "other"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.recordedTxHash(tt.txHash); got != tt.want {
+				t.Errorf("recordedTxHash(%q) = %q, want %q", tt.txHash, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// ticks of destroyed entities the replication log keeps (100ms tickrate, 100 = 10 seconds).
+// clients that last synced before that get a full snapshot from game-state-delta
+var ReplicationHistoryTicks uint64 = 100
+
+type replicatedState struct {
+	kind        string
+	team        string
+	fingerprint uint64
+}
+
+// fingerprints every unit, structure, projectile and special power after the tick has run and records
+// which ones were created, changed or destroyed per match for the game-state-delta query
+func ReplicationSystem(world cardinal.WorldContext) error {
+	tick := world.CurrentTick()
+
+	current := make(map[string]map[int]replicatedState)
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.MatchId](), filter.Component[comp.UID]())).
+		Each(world, func(id types.EntityID) bool {
+			kind := replicatedKind(world, id)
+			if kind == "" { //game state and helper entities are not sent to clients
+				return true
+			}
+			matchID, uid, err := GetComponents2[comp.MatchId, comp.UID](world, id)
+			if err != nil {
+				fmt.Printf("error getting replicated entity components (replication.go): %v \n", err)
+				return false
+			}
+			fingerprint, err := replicationFingerprint(world, id)
+			if err != nil {
+				fmt.Printf("error fingerprinting entity (replication.go): %v \n", err)
+				return false
+			}
+			if current[matchID.MatchId] == nil {
+				current[matchID.MatchId] = make(map[int]replicatedState)
+			}
+			current[matchID.MatchId][uid.UID] = replicatedState{kind: kind, team: replicatedTeam(world, id), fingerprint: fingerprint}
+			return true
+		})
+	if err != nil {
+		return fmt.Errorf("error searching replicated entities (replication.go): %w", err)
+	}
+
+	logged := make(map[string]bool)
+	err = cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.Replication]())).
+		Each(world, func(id types.EntityID) bool {
+			matchID, replication, err := GetComponents2[comp.MatchId, comp.Replication](world, id)
+			if err != nil {
+				fmt.Printf("error getting replication log (replication.go): %v \n", err)
+				return false
+			}
+			logged[matchID.MatchId] = true
+			updateReplication(replication, current[matchID.MatchId], tick)
+			if err := cardinal.SetComponent(world, id, replication); err != nil {
+				fmt.Printf("error setting replication log (replication.go): %v \n", err)
+				return false
+			}
+			return true
+		})
+	if err != nil {
+		return fmt.Errorf("error searching replication logs (replication.go): %w", err)
+	}
+
+	//matches that got their first replicated entities (the bases) this tick, in id order so entity ids stay deterministic
+	var started []string
+	for matchID := range current {
+		if !logged[matchID] {
+			started = append(started, matchID)
+		}
+	}
+	sort.Strings(started)
+	for _, matchID := range started {
+		replication := &comp.Replication{OldestTick: tick, Entities: make(map[int]comp.ReplicatedEntity)}
+		updateReplication(replication, current[matchID], tick)
+		if _, err := cardinal.Create(world, comp.MatchId{MatchId: matchID}, *replication); err != nil {
+			return fmt.Errorf("error creating replication log (replication.go): %w", err)
+		}
+	}
+	return nil
+}
+
+// compares the log with this ticks fingerprints and drops removals older than ReplicationHistoryTicks
+func updateReplication(replication *comp.Replication, current map[int]replicatedState, tick uint64) {
+	for uid, state := range current {
+		logged, ok := replication.Entities[uid]
+		if ok && logged.Fingerprint == state.fingerprint {
+			continue
+		}
+		replication.Entities[uid] = comp.ReplicatedEntity{Kind: state.kind, Team: state.team, Fingerprint: state.fingerprint, ChangedTick: tick}
+	}
+
+	var removed []int
+	for uid := range replication.Entities {
+		if _, ok := current[uid]; !ok {
+			removed = append(removed, uid)
+		}
+	}
+	sort.Ints(removed)
+	for _, uid := range removed {
+		logged := replication.Entities[uid]
+		replication.Removed = append(replication.Removed, comp.RemovedEntity{UID: uid, Kind: logged.Kind, Team: logged.Team, Tick: tick})
+		delete(replication.Entities, uid)
+	}
+
+	//removals are in tick order, forget the expired ones and with them the ticks deltas can start from
+	if tick > ReplicationHistoryTicks {
+		expired := 0
+		for expired < len(replication.Removed) && replication.Removed[expired].Tick <= tick-ReplicationHistoryTicks {
+			replication.OldestTick = max(replication.OldestTick, replication.Removed[expired].Tick)
+			expired++
+		}
+		replication.Removed = replication.Removed[expired:]
+	}
+	replication.Tick = tick
+}
+
+// which game-state list an entity is replicated in, empty if clients don't see it
+func replicatedKind(world cardinal.WorldContext, id types.EntityID) string {
+	if _, err := cardinal.GetComponent[comp.UnitTag](world, id); err == nil {
+		return "unit"
+	}
+	if _, err := cardinal.GetComponent[comp.StructureTag](world, id); err == nil {
+		return "structure"
+	}
+	if _, err := cardinal.GetComponent[comp.ProjectileTag](world, id); err == nil {
+		return "projectile"
+	}
+	if _, err := cardinal.GetComponent[comp.SpName](world, id); err == nil {
+		return "sp"
+	}
+	return ""
+}
+
+// team an entity plays for, projectiles and special powers play for the team of the unit that made them
+func replicatedTeam(world cardinal.WorldContext, id types.EntityID) string {
+	if owner, err := cardinal.GetComponent[comp.Owner](world, id); err == nil {
+		return owner.Team
+	}
+	if team, err := cardinal.GetComponent[comp.Team](world, id); err == nil {
+		return team.Team
+	}
+	return ""
+}
+
+// hash over every component the game-state query reads from an entity
+func replicationFingerprint(world cardinal.WorldContext, id types.EntityID) (uint64, error) {
+	var parts []any
+	parts = appendReplicated[comp.Team](world, id, parts)
+	parts = appendReplicated[comp.Health](world, id, parts)
+	parts = appendReplicated[comp.Position](world, id, parts)
+	parts = appendReplicated[comp.Movespeed](world, id, parts)
+	parts = appendReplicated[comp.UnitName](world, id, parts)
+	parts = appendReplicated[comp.SpName](world, id, parts)
+	parts = appendReplicated[comp.State](world, id, parts)
+	parts = appendReplicated[comp.Attack](world, id, parts)
+	parts = appendReplicated[comp.Sp](world, id, parts)
+	parts = appendReplicated[comp.CC](world, id, parts)
+	parts = appendReplicated[comp.StatusEffects](world, id, parts)
+
+	raw, err := json.Marshal(parts)
+	if err != nil {
+		return 0, fmt.Errorf("error encoding entity (replication.go/replicationFingerprint): %w", err)
+	}
+	h := fnv.New64a()
+	h.Write(raw)
+	return h.Sum64(), nil
+}
+
+// appends the component if the entity has it, a missing component is encoded as null so fields can't shift
+func appendReplicated[T types.Component](world cardinal.WorldContext, id types.EntityID, parts []any) []any {
+	component, err := cardinal.GetComponent[T](world, id)
+	if err != nil {
+		return append(parts, nil)
+	}
+	return append(parts, component)
+}
@@ -0,0 +1,110 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestUpdateReplication(t *testing.T) {
+	mage := replicatedState{kind: "unit", team: "Blue", fingerprint: 1}
+	tower := replicatedState{kind: "structure", team: "Red", fingerprint: 2}
+	arrow := replicatedState{kind: "projectile", team: "Red", fingerprint: 3}
+	movedMage := replicatedState{kind: "unit", team: "Blue", fingerprint: 4}
+
+	type step struct {
+		tick    uint64
+		current map[int]replicatedState
+	}
+	tests := []struct {
+		name         string
+		steps        []step
+		wantEntities map[int]comp.ReplicatedEntity
+		wantRemoved  []comp.RemovedEntity
+		wantOldest   uint64
+	}{
+		{
+			name:  "new entities change on the tick they appear",
+			steps: []step{{10, map[int]replicatedState{1: mage, 2: tower}}},
+			wantEntities: map[int]comp.ReplicatedEntity{
+				1: {Kind: "unit", Team: "Blue", Fingerprint: 1, ChangedTick: 10},
+				2: {Kind: "structure", Team: "Red", Fingerprint: 2, ChangedTick: 10},
+			},
+		},
+		{
+			name: "only changed fingerprints move the changed tick",
+			steps: []step{
+				{10, map[int]replicatedState{1: mage, 2: tower}},
+				{11, map[int]replicatedState{1: movedMage, 2: tower}},
+				{12, map[int]replicatedState{1: movedMage, 2: tower}},
+			},
+			wantEntities: map[int]comp.ReplicatedEntity{
+				1: {Kind: "unit", Team: "Blue", Fingerprint: 4, ChangedTick: 11},
+				2: {Kind: "structure", Team: "Red", Fingerprint: 2, ChangedTick: 10},
+			},
+		},
+		{
+			name: "gone entities are logged as removed in uid order",
+			steps: []step{
+				{10, map[int]replicatedState{1: mage, 2: tower, 3: arrow}},
+				{11, map[int]replicatedState{2: tower}},
+			},
+			wantEntities: map[int]comp.ReplicatedEntity{
+				2: {Kind: "structure", Team: "Red", Fingerprint: 2, ChangedTick: 10},
+			},
+			wantRemoved: []comp.RemovedEntity{
+				{UID: 1, Kind: "unit", Team: "Blue", Tick: 11},
+				{UID: 3, Kind: "projectile", Team: "Red", Tick: 11},
+			},
+		},
+		{
+			name: "removals are kept for the history window",
+			steps: []step{
+				{10, map[int]replicatedState{1: mage, 2: tower}},
+				{50, map[int]replicatedState{2: tower}},
+				{50 + ReplicationHistoryTicks - 1, map[int]replicatedState{2: tower}},
+			},
+			wantEntities: map[int]comp.ReplicatedEntity{
+				2: {Kind: "structure", Team: "Red", Fingerprint: 2, ChangedTick: 10},
+			},
+			wantRemoved: []comp.RemovedEntity{{UID: 1, Kind: "unit", Team: "Blue", Tick: 50}},
+		},
+		{
+			name: "expired removals are forgotten and move the oldest tick",
+			steps: []step{
+				{10, map[int]replicatedState{1: mage, 2: tower, 3: arrow}},
+				{50, map[int]replicatedState{2: tower, 3: arrow}},
+				{80, map[int]replicatedState{2: tower}},
+				{50 + ReplicationHistoryTicks, map[int]replicatedState{2: tower}},
+			},
+			wantEntities: map[int]comp.ReplicatedEntity{
+				2: {Kind: "structure", Team: "Red", Fingerprint: 2, ChangedTick: 10},
+			},
+			wantRemoved: []comp.RemovedEntity{{UID: 3, Kind: "projectile", Team: "Red", Tick: 80}},
+			wantOldest:  50,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replication := &comp.Replication{Entities: make(map[int]comp.ReplicatedEntity)}
+			for _, s := range tt.steps {
+				updateReplication(replication, s.current, s.tick)
+			}
+			if last := tt.steps[len(tt.steps)-1].tick; replication.Tick != last {
+				t.Errorf("Tick = %d, want %d", replication.Tick, last)
+			}
+			if !reflect.DeepEqual(replication.Entities, tt.wantEntities) {
+				t.Errorf("Entities = %+v, want %+v", replication.Entities, tt.wantEntities)
+			}
+			if len(replication.Removed) != 0 || len(tt.wantRemoved) != 0 {
+				if !reflect.DeepEqual(replication.Removed, tt.wantRemoved) {
+					t.Errorf("Removed = %+v, want %+v", replication.Removed, tt.wantRemoved)
+				}
+			}
+			if replication.OldestTick != tt.wantOldest {
+				t.Errorf("OldestTick = %d, want %d", replication.OldestTick, tt.wantOldest)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package system
 import (
 	comp "MobaClashRoyal/component"
 	"fmt"
+	"math"
 
 	"pkg.world.dev/world-engine/cardinal"
 	"pkg.world.dev/world-engine/cardinal/search/filter"
@@ -15,20 +16,40 @@ var goldGen float32 = .1
 func GoldGeneration(world cardinal.WorldContext) error {
 
 	err := cardinal.NewSearch().Entity(
-		filter.Contains(GameStateFilters())).
+		filter.Contains(GameStateFilters()...)).
 		Each(world, func(id types.EntityID) bool {
+			//overtime and sudden death speed up gold
+			timer, err := cardinal.GetComponent[comp.MatchTimer](world, id)
+			if err != nil {
+				fmt.Printf("error getting match timer (resource_management.go): %v\n", err)
+				return false
+			}
+			//ended matches are frozen
+			if timer.Phase == "Ended" {
+				return true
+			}
+			gold := goldGen * phaseGoldMultiplier[timer.Phase]
+
+			matchID, err := cardinal.GetComponent[comp.MatchId](world, id)
+			if err != nil {
+				fmt.Printf("error getting match id (resource_management.go): %v\n", err)
+				return false
+			}
 
 			//increment player1 gold
-			err := cardinal.UpdateComponent(world, id, func(player1 *comp.Player1) *comp.Player1 {
+			var blueBefore, blueAfter float32
+			err = cardinal.UpdateComponent(world, id, func(player1 *comp.Player1) *comp.Player1 {
 				if player1 == nil {
 					fmt.Printf("error getting player1 gold (resource_management.go):\n")
 					return nil
 				}
-				player1.Gold += goldGen
+				blueBefore = player1.Gold
+				player1.Gold += gold
 				//cap gold to 10
 				if player1.Gold > 10 {
 					player1.Gold = 10
 				}
+				blueAfter = player1.Gold
 
 				return player1
 			})
@@ -38,21 +59,42 @@ func GoldGeneration(world cardinal.WorldContext) error {
 			}
 
 			//increment player2 gold
+			var redBefore, redAfter float32
 			err = cardinal.UpdateComponent(world, id, func(player2 *comp.Player2) *comp.Player2 {
 				if player2 == nil {
 					fmt.Printf("error getting player2 gold (resource_management.go):\n")
 					return nil
 				}
-				player2.Gold += goldGen
+				redBefore = player2.Gold
+				player2.Gold += gold
 				//cap gold to 10
 				if player2.Gold > 10 {
 					player2.Gold = 10
 				}
+				redAfter = player2.Gold
 				return player2
 			})
 
+			if err != nil {
+				return false
+			}
+
+			//only whole gold is worth an event, clients interpolate the fraction from the regen rate
+			if err = emitGoldEvent(world, matchID.MatchId, "Blue", blueBefore, blueAfter); err != nil {
+				fmt.Printf("(resource_management.go): %v\n", err)
+				return false
+			}
+			err = emitGoldEvent(world, matchID.MatchId, "Red", redBefore, redAfter)
 			return err == nil
 		})
 
 	return err
 }
+
+// emits a gold event for team if its gold crossed a whole number
+func emitGoldEvent(world cardinal.WorldContext, matchID, team string, before, after float32) error {
+	if math.Floor(float64(before)) == math.Floor(float64(after)) {
+		return nil
+	}
+	return emitMatchEvent(world, matchID, comp.MatchEvent{Type: "gold", Team: team, Value: after})
+}
@@ -0,0 +1,50 @@
+package system
+
+import (
+	"slices"
+
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+// Rules are the settings the message systems are built with when the world is set up (shard.MustInitWorld).
+// a shard builds them from world.toml, only the replay tool sets Replay so a live shard never re-simulates
+type Rules struct {
+	AdminPersonas []string       //persona tags allowed to send admin messages (remove-all-entities)
+	Replay        *ReplaySession //set while a recorded match is being re-simulated
+}
+
+// hashes the recorded transactions had, keyed by the hash the same transaction got when it was replayed,
+// so seeds come out the same as in the recorded match
+type ReplaySession struct {
+	txHashes map[types.TxHash]types.TxHash
+}
+
+func NewReplaySession() *ReplaySession {
+	return &ReplaySession{txHashes: make(map[types.TxHash]types.TxHash)}
+}
+
+// Record maps the hash a replayed transaction got to the hash it was recorded with
+func (s *ReplaySession) Record(replayed, recorded types.TxHash) {
+	s.txHashes[replayed] = recorded
+}
+
+// true if the persona is an admin
+func (r Rules) isAdmin(personaTag string) bool {
+	return slices.Contains(r.AdminPersonas, personaTag)
+}
+
+// true while a recorded match is being re-simulated
+func (r Rules) replaying() bool {
+	return r.Replay != nil
+}
+
+// hash the transaction had when the match was recorded
+func (r Rules) recordedTxHash(txHash types.TxHash) types.TxHash {
+	if !r.replaying() {
+		return txHash
+	}
+	if recorded, ok := r.Replay.txHashes[txHash]; ok {
+		return recorded
+	}
+	return txHash
+}
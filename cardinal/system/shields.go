@@ -0,0 +1,103 @@
+package system
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// gives a unit or structure a shield absorbing amount damage for ticks, replacing an earlier shield from source
+func grantShield(world cardinal.WorldContext, id types.EntityID, source string, amount float32, ticks int) error {
+	if amount <= 0 || ticks <= 0 {
+		return nil
+	}
+	err := cardinal.UpdateComponent(world, id, func(health *comp.Health) *comp.Health {
+		if health == nil {
+			fmt.Printf("error retrieving health component (shields.go/grantShield) \n")
+			return nil
+		}
+		layer := comp.ShieldLayer{Source: source, Amount: amount, RemainingTicks: ticks}
+		for i := range health.Shields {
+			if health.Shields[i].Source == source {
+				health.Shields[i] = layer
+				return health
+			}
+		}
+		health.Shields = append(health.Shields, layer)
+		return health
+	})
+	if err != nil {
+		return fmt.Errorf("error granting shield (shields.go/grantShield): %w", err)
+	}
+	return nil
+}
+
+// takes damage off the shields, the layer closest to expiring first, and returns what got through to hp
+func absorbDamage(health *comp.Health, damage float32) float32 {
+	sort.SliceStable(health.Shields, func(i, j int) bool {
+		return health.Shields[i].RemainingTicks < health.Shields[j].RemainingTicks
+	})
+	kept := health.Shields[:0]
+	for _, layer := range health.Shields {
+		absorbed := min(layer.Amount, damage)
+		layer.Amount -= absorbed
+		damage -= absorbed
+		if layer.Amount > 0 {
+			kept = append(kept, layer)
+		}
+	}
+	health.Shields = kept
+	return damage
+}
+
+// ShieldAmount returns the damage the shields of a unit or structure still absorb
+func ShieldAmount(health *comp.Health) float32 {
+	var total float32
+	for _, layer := range health.Shields {
+		total += layer.Amount
+	}
+	return total
+}
+
+// counts down every shield and drops the ones that ran out
+func ShieldSystem(world cardinal.WorldContext) error {
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
+
+	return cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.Health]())).
+		Where(activeFilter).
+		Each(world, func(id types.EntityID) bool {
+			health, err := cardinal.GetComponent[comp.Health](world, id)
+			if err != nil {
+				fmt.Printf("error getting health (shields.go): %v \n", err)
+				return false
+			}
+			if len(health.Shields) == 0 {
+				return true
+			}
+
+			kept := health.Shields[:0]
+			for _, layer := range health.Shields {
+				layer.RemainingTicks--
+				if layer.RemainingTicks > 0 {
+					kept = append(kept, layer)
+				}
+			}
+			health.Shields = kept
+
+			if err := cardinal.SetComponent(world, id, health); err != nil {
+				fmt.Printf("error setting health (shields.go): %v \n", err)
+				return false
+			}
+			return true
+		})
+}
@@ -0,0 +1,142 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestAbsorbDamage(t *testing.T) {
+	tests := []struct {
+		name        string
+		shields     []comp.ShieldLayer
+		damage      float32
+		wantThrough float32
+		wantShields []comp.ShieldLayer
+	}{
+		{
+			name:        "no shields",
+			damage:      10,
+			wantThrough: 10,
+			wantShields: nil,
+		},
+		{
+			name:        "fully absorbed",
+			shields:     []comp.ShieldLayer{{Source: "Barrier", Amount: 30, RemainingTicks: 50}},
+			damage:      10,
+			wantThrough: 0,
+			wantShields: []comp.ShieldLayer{{Source: "Barrier", Amount: 20, RemainingTicks: 50}},
+		},
+		{
+			name:        "exactly used up",
+			shields:     []comp.ShieldLayer{{Source: "Barrier", Amount: 10, RemainingTicks: 50}},
+			damage:      10,
+			wantThrough: 0,
+			wantShields: []comp.ShieldLayer{},
+		},
+		{
+			name:        "broken shield lets the rest through",
+			shields:     []comp.ShieldLayer{{Source: "Barrier", Amount: 4, RemainingTicks: 50}},
+			damage:      10,
+			wantThrough: 6,
+			wantShields: []comp.ShieldLayer{},
+		},
+		{
+			name: "closest to expiring used first",
+			shields: []comp.ShieldLayer{
+				{Source: "long", Amount: 10, RemainingTicks: 40},
+				{Source: "short", Amount: 10, RemainingTicks: 5},
+			},
+			damage:      6,
+			wantThrough: 0,
+			wantShields: []comp.ShieldLayer{
+				{Source: "short", Amount: 4, RemainingTicks: 5},
+				{Source: "long", Amount: 10, RemainingTicks: 40},
+			},
+		},
+		{
+			name: "partial absorb across layers",
+			shields: []comp.ShieldLayer{
+				{Source: "long", Amount: 10, RemainingTicks: 40},
+				{Source: "short", Amount: 5, RemainingTicks: 5},
+			},
+			damage:      12,
+			wantThrough: 0,
+			wantShields: []comp.ShieldLayer{
+				{Source: "long", Amount: 3, RemainingTicks: 40},
+			},
+		},
+		{
+			name: "every layer broken",
+			shields: []comp.ShieldLayer{
+				{Source: "a", Amount: 5, RemainingTicks: 10},
+				{Source: "b", Amount: 5, RemainingTicks: 20},
+			},
+			damage:      25,
+			wantThrough: 15,
+			wantShields: []comp.ShieldLayer{},
+		},
+		{
+			name: "equal ticks keep their order",
+			shields: []comp.ShieldLayer{
+				{Source: "first", Amount: 5, RemainingTicks: 10},
+				{Source: "second", Amount: 5, RemainingTicks: 10},
+			},
+			damage:      7,
+			wantThrough: 0,
+			wantShields: []comp.ShieldLayer{
+				{Source: "second", Amount: 3, RemainingTicks: 10},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := &comp.Health{CurrentHP: 100, MaxHP: 100, Shields: tt.shields}
+			if got := absorbDamage(health, tt.damage); got != tt.wantThrough {
+				t.Errorf("absorbDamage() = %v, want %v", got, tt.wantThrough)
+			}
+			if !reflect.DeepEqual(health.Shields, tt.wantShields) {
+				t.Errorf("shields = %v, want %v", health.Shields, tt.wantShields)
+			}
+			if health.CurrentHP != 100 {
+				t.Errorf("absorbDamage changed hp to %v", health.CurrentHP)
+			}
+		})
+	}
+}
+
+func TestTakeDamage(t *testing.T) {
+	barrier := func() []comp.ShieldLayer {
+		return []comp.ShieldLayer{{Source: "Barrier", Amount: EffectRegistry["Barrier"].Shield, RemainingTicks: 50}}
+	}
+	tests := []struct {
+		name         string
+		hp           float32
+		shields      []comp.ShieldLayer
+		damage       float32
+		wantHP       float32
+		wantDealt    float32
+		wantAbsorbed float32
+		wantKilled   bool
+	}{
+		{"unshielded hit", 100, nil, 15, 85, 15, 0, false},
+		{"absorbed hit leaves hp untouched", 100, barrier(), 15, 100, 0, 15, false},
+		{"hit breaking the barrier", 100, barrier(), 45, 85, 15, 30, false},
+		{"overkill stops at 0", 10, nil, 25, 0, 10, 0, true},
+		{"barrier saves a dying unit", 10, barrier(), 25, 10, 0, 25, false},
+		{"already dead is not killed again", 0, nil, 5, 0, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := &comp.Health{CurrentHP: tt.hp, MaxHP: 100, Shields: tt.shields}
+			dealt, absorbed, killed := takeDamage(health, tt.damage)
+			if dealt != tt.wantDealt || absorbed != tt.wantAbsorbed || killed != tt.wantKilled {
+				t.Errorf("takeDamage() = (%v, %v, %v), want (%v, %v, %v)", dealt, absorbed, killed, tt.wantDealt, tt.wantAbsorbed, tt.wantKilled)
+			}
+			if health.CurrentHP != tt.wantHP {
+				t.Errorf("hp = %v, want %v", health.CurrentHP, tt.wantHP)
+			}
+		})
+	}
+}
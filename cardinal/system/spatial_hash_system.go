@@ -165,6 +165,45 @@ func CheckCollisionSpatialHashList(hash *comp.SpatialHash, x, y float32, radius
 	return collidedUnits
 }
 
+// FindEnemiesInRadiusSpatialHash returns every object not on team that overlaps the circle at (x, y), each once.
+// used for sight, so air and ground are both found
+func FindEnemiesInRadiusSpatialHash(hash *comp.SpatialHash, x, y float32, radius int, team string) []types.EntityID {
+	return findInRadiusSpatialHash(hash, x, y, radius, func(objTeam string) bool { return objTeam != team })
+}
+
+// FindAlliesInRadiusSpatialHash returns every object on team that overlaps the circle at (x, y), each once
+func FindAlliesInRadiusSpatialHash(hash *comp.SpatialHash, x, y float32, radius int, team string) []types.EntityID {
+	return findInRadiusSpatialHash(hash, x, y, radius, func(objTeam string) bool { return objTeam == team })
+}
+
+// every object overlapping the circle at (x, y) whose team is kept, each once
+func findInRadiusSpatialHash(hash *comp.SpatialHash, x, y float32, radius int, keep func(team string) bool) []types.EntityID {
+	//get range of cells covered
+	startCellX, endCellX, startCellY, endCellY := calculateCellRangeSpatialHash(hash, x, y, radius)
+	found := make(map[types.EntityID]bool)
+	objects := []types.EntityID{}
+
+	// Loop over all cells the circle might touch
+	for cx := startCellX; cx <= endCellX; cx++ {
+		for cy := startCellY; cy <= endCellY; cy++ {
+			hashKey := fmt.Sprintf("%d,%d", cx, cy)
+			if cell, exists := hash.Cells[hashKey]; exists {
+				for i, unitID := range cell.UnitIDs {
+					if !keep(cell.Team[i]) || found[unitID] {
+						continue
+					}
+					if intersectSpatialHash(x, y, radius, cell.PositionsX[i], cell.PositionsY[i], cell.Radii[i]) {
+						found[unitID] = true
+						objects = append(objects, unitID)
+					}
+				}
+			}
+		}
+	}
+
+	return objects
+}
+
 // GetEntitiesInCell retrieves all entity IDs present in the spatial hash cell for a given x and y position.
 func GetEntitiesInCell(hash *comp.SpatialHash, x, y float32) []types.EntityID {
 	// Calculate the cell coordinates that correspond to the given (x, y) position
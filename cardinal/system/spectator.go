@@ -0,0 +1,294 @@
+package system
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+)
+
+// ticks spectators are behind the match so they can't feed a player what the enemy is doing (set from world.toml)
+var SpectatorDelayTicks uint64 = 300
+
+// ticks between recorded spectator frames (100ms tickrate, 10 = 1 second), the buffer holds about
+// SpectatorDelayTicks/SpectatorFrameInterval frames per watched match
+var SpectatorFrameInterval uint64 = 10
+
+// registers the sender as a spectator of a running match they don't play in
+func SpectateSystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(world,
+		func(spectate cardinal.TxData[msg.SpectateMsg]) (msg.SpectateResult, error) {
+			gameState, err := getGameStateGSS(world, &comp.MatchId{MatchId: spectate.Msg.MatchID})
+			if err != nil {
+				return msg.SpectateResult{Success: false}, fmt.Errorf("match has not started (spectator.go): %w", ErrMatchNotFound)
+			}
+			timer, err := cardinal.GetComponent[comp.MatchTimer](world, gameState)
+			if err != nil {
+				return msg.SpectateResult{Success: false}, fmt.Errorf("error getting match timer (spectator.go): %w", err)
+			}
+			if timer.Phase == "Ended" {
+				return msg.SpectateResult{Success: false}, fmt.Errorf("%w (spectator.go): %s", ErrMatchEnded, spectate.Msg.MatchID)
+			}
+			//a player watching their own match would see the enemy's hand
+			if _, err := authorizePlayer(world, gameState, spectate.Tx.PersonaTag); err == nil {
+				return msg.SpectateResult{Success: false}, fmt.Errorf("%w (spectator.go): %s", ErrPlayerSpectate, spectate.Tx.PersonaTag)
+			}
+
+			spectatorsID, spectators, err := getSpectators(world, spectate.Msg.MatchID)
+			if err != nil {
+				return msg.SpectateResult{Success: false}, err
+			}
+			if spectatorsID == iterators.BadID {
+				_, err = cardinal.Create(world,
+					comp.MatchId{MatchId: spectate.Msg.MatchID},
+					comp.Spectators{Personas: []string{spectate.Tx.PersonaTag}})
+				if err != nil {
+					return msg.SpectateResult{Success: false}, fmt.Errorf("error creating spectators (spectator.go): %w", err)
+				}
+				return msg.SpectateResult{Success: true, DelayTicks: SpectatorDelayTicks}, nil
+			}
+
+			if slices.Contains(spectators.Personas, spectate.Tx.PersonaTag) {
+				return msg.SpectateResult{Success: false}, fmt.Errorf("%w (spectator.go): %s", ErrSpectating, spectate.Tx.PersonaTag)
+			}
+			spectators.Personas = append(spectators.Personas, spectate.Tx.PersonaTag)
+			if err := cardinal.SetComponent(world, spectatorsID, spectators); err != nil {
+				return msg.SpectateResult{Success: false}, fmt.Errorf("error setting spectators (spectator.go): %w", err)
+			}
+			return msg.SpectateResult{Success: true, DelayTicks: SpectatorDelayTicks}, nil
+		})
+}
+
+// removes the sender from the spectators of a match
+func StopSpectatingSystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(world,
+		func(stop cardinal.TxData[msg.StopSpectatingMsg]) (msg.StopSpectatingResult, error) {
+			spectatorsID, spectators, err := getSpectators(world, stop.Msg.MatchID)
+			if err != nil {
+				return msg.StopSpectatingResult{Success: false}, err
+			}
+			if spectatorsID == iterators.BadID || !slices.Contains(spectators.Personas, stop.Tx.PersonaTag) {
+				return msg.StopSpectatingResult{Success: false}, fmt.Errorf("%w (spectator.go): %s", ErrNotSpectating, stop.Tx.PersonaTag)
+			}
+			spectators.Personas = slices.DeleteFunc(spectators.Personas, func(persona string) bool {
+				return persona == stop.Tx.PersonaTag
+			})
+			if err := cardinal.SetComponent(world, spectatorsID, spectators); err != nil {
+				return msg.StopSpectatingResult{Success: false}, fmt.Errorf("error setting spectators (spectator.go): %w", err)
+			}
+			return msg.StopSpectatingResult{Success: true}, nil
+		})
+}
+
+// records a frame of every running match that has spectators every SpectatorFrameInterval ticks into the match's
+// frame buffer and drops the frames spectators have moved past. matches nobody watches anymore lose their frames
+func SpectatorFrameSystem(world cardinal.WorldContext) error {
+	tick := world.CurrentTick()
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
+
+	watched := make(map[string]bool)
+	err = cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.Spectators]())).
+		Where(activeFilter).
+		Each(world, func(id types.EntityID) bool {
+			matchID, spectators, err := GetComponents2[comp.MatchId, comp.Spectators](world, id)
+			if err != nil {
+				fmt.Printf("error getting spectators (spectator.go): %v \n", err)
+				return false
+			}
+			if len(spectators.Personas) > 0 {
+				watched[matchID.MatchId] = true
+			}
+			return true
+		})
+	if err != nil {
+		return fmt.Errorf("error searching spectators (spectator.go): %w", err)
+	}
+
+	//buffers are created and removed after the search so it doesn't see its own changes
+	buffered := make(map[string]bool)
+	var unwatched []types.EntityID
+	err = cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.SpectatorFrames]())).
+		Where(activeFilter).
+		Each(world, func(id types.EntityID) bool {
+			matchID, frames, err := GetComponents2[comp.MatchId, comp.SpectatorFrames](world, id)
+			if err != nil {
+				fmt.Printf("error getting spectator frames (spectator.go): %v \n", err)
+				return false
+			}
+			if !watched[matchID.MatchId] {
+				unwatched = append(unwatched, id)
+				return true
+			}
+			buffered[matchID.MatchId] = true
+			if tick%SpectatorFrameInterval == 0 {
+				frame, err := spectatorFrame(world, matchID.MatchId, tick)
+				if err != nil {
+					fmt.Printf("error recording spectator frame of %s (spectator.go): %v \n", matchID.MatchId, err)
+					return true
+				}
+				frames.Frames = append(frames.Frames, *frame)
+			}
+			frames.Frames = trimSpectatorFrames(frames.Frames, tick)
+			if err := cardinal.SetComponent(world, id, frames); err != nil {
+				fmt.Printf("error setting spectator frames (spectator.go): %v \n", err)
+				return false
+			}
+			return true
+		})
+	if err != nil {
+		return fmt.Errorf("error searching spectator frames (spectator.go): %w", err)
+	}
+	for _, id := range unwatched {
+		if err := cardinal.Remove(world, id); err != nil {
+			return fmt.Errorf("error removing spectator frames (spectator.go): %w", err)
+		}
+	}
+
+	//newly watched matches start their buffer with a frame of this tick, in match id order so entity ids stay deterministic
+	var start []string
+	for matchID := range watched {
+		if !buffered[matchID] {
+			start = append(start, matchID)
+		}
+	}
+	sort.Strings(start)
+	for _, matchID := range start {
+		frame, err := spectatorFrame(world, matchID, tick)
+		if err != nil {
+			fmt.Printf("error recording spectator frame of %s (spectator.go): %v \n", matchID, err)
+			continue
+		}
+		if _, err := cardinal.Create(world, comp.MatchId{MatchId: matchID}, comp.SpectatorFrames{Frames: []comp.SpectatorFrame{*frame}}); err != nil {
+			return fmt.Errorf("error creating spectator frames (spectator.go): %w", err)
+		}
+	}
+	return nil
+}
+
+// drops the frames older than the newest one at least SpectatorDelayTicks old, that one is still shown
+func trimSpectatorFrames(frames []comp.SpectatorFrame, tick uint64) []comp.SpectatorFrame {
+	shown := 0
+	for i, frame := range frames {
+		if frame.Tick+SpectatorDelayTicks <= tick {
+			shown = i
+		}
+	}
+	return frames[shown:]
+}
+
+// newest frame at least SpectatorDelayTicks old, nil if there is none yet
+func SpectatorFrameAt(frames *comp.SpectatorFrames, tick uint64) *comp.SpectatorFrame {
+	var shown *comp.SpectatorFrame
+	for i := range frames.Frames {
+		if frames.Frames[i].Tick+SpectatorDelayTicks <= tick {
+			shown = &frames.Frames[i]
+		}
+	}
+	return shown
+}
+
+// spectators entity of a match, BadID if nobody spectated it yet
+func getSpectators(world cardinal.WorldContext, matchID string) (types.EntityID, *comp.Spectators, error) {
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == matchID
+	})
+	spectatorsID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.Spectators]())).
+		Where(matchFilter).First(world)
+	if err != nil {
+		return iterators.BadID, nil, fmt.Errorf("error searching for spectators (spectator.go/getSpectators): %w", err)
+	}
+	if spectatorsID == iterators.BadID {
+		return iterators.BadID, nil, nil
+	}
+	spectators, err := cardinal.GetComponent[comp.Spectators](world, spectatorsID)
+	if err != nil {
+		return iterators.BadID, nil, fmt.Errorf("error getting spectators (spectator.go/getSpectators): %w", err)
+	}
+	return spectatorsID, spectators, nil
+}
+
+// SpectatorNames returns the personas spectating a match, empty if nobody does
+func SpectatorNames(world cardinal.WorldContext, matchID string) ([]string, error) {
+	_, spectators, err := getSpectators(world, matchID)
+	if err != nil || spectators == nil {
+		return []string{}, err
+	}
+	return spectators.Personas, nil
+}
+
+// everything of a match on this tick: both players' hands and gold, the clock and every entity regardless of sight
+func spectatorFrame(world cardinal.WorldContext, matchID string, tick uint64) (*comp.SpectatorFrame, error) {
+	gameState, err := getGameStateGSS(world, &comp.MatchId{MatchId: matchID})
+	if err != nil {
+		return nil, err
+	}
+	p1, p2, err := getPlayerComponentsGSS(world, gameState)
+	if err != nil {
+		return nil, err
+	}
+	timer, err := cardinal.GetComponent[comp.MatchTimer](world, gameState)
+	if err != nil {
+		return nil, fmt.Errorf("error getting match timer (spectator.go/spectatorFrame): %w", err)
+	}
+
+	frame := &comp.SpectatorFrame{
+		Tick:           tick,
+		Phase:          timer.Phase,
+		TicksRemaining: timer.PhaseTicksLeft,
+		Blue:           comp.SpectatedPlayer{Nickname: p1.Nickname, Hand: p1.Hand, Gold: p1.Gold},
+		Red:            comp.SpectatedPlayer{Nickname: p2.Nickname, Hand: p2.Hand, Gold: p2.Gold},
+		Entities:       []comp.SpectatedEntity{},
+	}
+
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == matchID
+	})
+	err = cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.UID](), filter.Component[comp.Position]())).
+		Where(matchFilter).
+		Each(world, func(id types.EntityID) bool {
+			kind := replicatedKind(world, id)
+			if kind == "" {
+				return true
+			}
+			uid, pos, err := GetComponents2[comp.UID, comp.Position](world, id)
+			if err != nil {
+				fmt.Printf("error getting spectated entity (spectator.go/spectatorFrame): %v \n", err)
+				return false
+			}
+			entity := comp.SpectatedEntity{UID: uid.UID, Kind: kind, Team: replicatedTeam(world, id), Position: *pos}
+			if name, err := cardinal.GetComponent[comp.UnitName](world, id); err == nil {
+				entity.Name = name.UnitName
+			} else if spName, err := cardinal.GetComponent[comp.SpName](world, id); err == nil {
+				entity.Name = spName.SpName
+			}
+			if health, err := cardinal.GetComponent[comp.Health](world, id); err == nil {
+				entity.CurrentHP, entity.MaxHP = health.CurrentHP, health.MaxHP
+			}
+			if state, err := cardinal.GetComponent[comp.State](world, id); err == nil && kind == "structure" {
+				entity.State = state.State
+			}
+			frame.Entities = append(frame.Entities, entity)
+			return true
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error searching spectated entities (spectator.go/spectatorFrame): %w", err)
+	}
+	sort.Slice(frame.Entities, func(i, j int) bool {
+		return frame.Entities[i].UID < frame.Entities[j].UID
+	})
+	return frame, nil
+}
@@ -0,0 +1,76 @@
+package system
+
+import (
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+// frames recorded every SpectatorFrameInterval ticks from first to last
+func testSpectatorFrames(first, last uint64) []comp.SpectatorFrame {
+	var frames []comp.SpectatorFrame
+	for tick := first; tick <= last; tick += SpectatorFrameInterval {
+		frames = append(frames, comp.SpectatorFrame{Tick: tick})
+	}
+	return frames
+}
+
+func TestSpectatorFrameAt(t *testing.T) {
+	delay := SpectatorDelayTicks
+	frames := &comp.SpectatorFrames{Frames: testSpectatorFrames(100, 100+delay+50)}
+	tests := []struct {
+		name     string
+		tick     uint64
+		wantTick uint64
+		wantNone bool
+	}{
+		{"nothing is old enough yet", 100 + delay - 1, 0, true},
+		{"first frame once the delay passed", 100 + delay, 100, false},
+		{"between frames shows the older one", 100 + delay + 15, 110, false},
+		{"exactly on a frame", 100 + delay + 20, 120, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SpectatorFrameAt(frames, tt.tick)
+			if tt.wantNone {
+				if got != nil {
+					t.Errorf("SpectatorFrameAt(%d) = frame of tick %d, want none", tt.tick, got.Tick)
+				}
+				return
+			}
+			if got == nil || got.Tick != tt.wantTick {
+				t.Errorf("SpectatorFrameAt(%d) = %+v, want the frame of tick %d", tt.tick, got, tt.wantTick)
+			}
+		})
+	}
+}
+
+func TestTrimSpectatorFrames(t *testing.T) {
+	delay := SpectatorDelayTicks
+	tests := []struct {
+		name      string
+		frames    []comp.SpectatorFrame
+		tick      uint64
+		wantFirst uint64
+		wantLen   int
+	}{
+		{"nothing shown yet keeps everything", testSpectatorFrames(100, 300), 100 + delay - 1, 100, 21},
+		{"the shown frame is kept", testSpectatorFrames(100, 100+delay), 100 + delay, 100, 31},
+		{"frames before the shown one are dropped", testSpectatorFrames(100, 100+delay+50), 100 + delay + 55, 150, 31},
+		{"a single frame is kept", testSpectatorFrames(100, 100), 100 + delay + 500, 100, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trimSpectatorFrames(tt.frames, tt.tick)
+			if len(got) != tt.wantLen || got[0].Tick != tt.wantFirst {
+				t.Fatalf("trimSpectatorFrames(%d) kept %d frames from tick %d, want %d from tick %d", tt.tick, len(got), got[0].Tick, tt.wantLen, tt.wantFirst)
+			}
+			//spectators still see the same frame after the trim
+			before := SpectatorFrameAt(&comp.SpectatorFrames{Frames: tt.frames}, tt.tick)
+			after := SpectatorFrameAt(&comp.SpectatorFrames{Frames: got}, tt.tick)
+			if (before == nil) != (after == nil) || (before != nil && before.Tick != after.Tick) {
+				t.Errorf("trim changed the shown frame from %+v to %+v", before, after)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package system
+
+import (
+	"fmt"
+	"math"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// stats modifiers can change
+var statNames = map[string]bool{"Movespeed": true, "Damage": true, "AttackRate": true, "DamageTaken": true}
+
+// changes one stat, effective = (base + every Add) * every Mult. a 0 Mult leaves the stat unscaled
+type StatModifier struct {
+	Stat string  `json:"stat"` //Movespeed, Damage, AttackRate (ticks per attack, a lower rate attacks faster) or DamageTaken (base 1)
+	Add  float32 `json:"add"`
+	Mult float32 `json:"mult"`
+}
+
+// modifier a unit or structure gives everything of one side within radius of it
+type Aura struct {
+	Radius int  `json:"radius"`
+	Allies bool `json:"allies"` //true buffs its own team, false debuffs the enemy
+	StatModifier
+}
+
+// works out the effective movespeed, damage, attack rate and damage taken of every unit and structure from its base
+// stats and the modifiers of its status effects and the auras it stands in. runs before movement so the whole tick uses them
+func StatSystem(world cardinal.WorldContext) error {
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
+
+	//auras first so every entity sees the same ones
+	modifiers, err := auraModifiers(world, activeFilter)
+	if err != nil {
+		return err
+	}
+
+	return cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.BaseStats]())).
+		Where(activeFilter).
+		Each(world, func(id types.EntityID) bool {
+			mods := modifiers[id]
+			if effects, err := cardinal.GetComponent[comp.StatusEffects](world, id); err == nil {
+				mods = append(mods, effectModifiers(effects)...)
+			}
+			if err := applyStats(world, id, mods); err != nil {
+				fmt.Printf("(stats.go): %v \n", err)
+				return false
+			}
+			return true
+		})
+}
+
+// modifiers of a unit's status effects, once per stack
+func effectModifiers(effects *comp.StatusEffects) []StatModifier {
+	var mods []StatModifier
+	for _, effect := range effects.Effects {
+		for i := 0; i < effect.Stacks; i++ {
+			mods = append(mods, EffectRegistry[effect.Name].Modifiers...)
+		}
+	}
+	return mods
+}
+
+// modifiers every entity gets from the auras of living units and structures around it
+func auraModifiers(world cardinal.WorldContext, activeFilter cardinal.FilterFn) (map[types.EntityID][]StatModifier, error) {
+	modifiers := make(map[types.EntityID][]StatModifier)
+	hashes := make(map[string]*comp.SpatialHash)
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.BaseStats]())).
+		Where(activeFilter).
+		Each(world, func(id types.EntityID) bool {
+			name, team, pos, health, matchID, err := GetComponents5[comp.UnitName, comp.Team, comp.Position, comp.Health, comp.MatchId](world, id)
+			if err != nil {
+				fmt.Printf("aura source components (stats.go/auraModifiers): %v \n", err)
+				return false
+			}
+			auras := auraDefinitions(name.UnitName)
+			if len(auras) == 0 || health.CurrentHP <= 0 {
+				return true
+			}
+
+			hash, ok := hashes[matchID.MatchId]
+			if !ok {
+				hash, err = getCollisionHashGSS(world, matchID)
+				if err != nil {
+					fmt.Printf("(stats.go/auraModifiers): %v \n", err)
+					return false
+				}
+				hashes[matchID.MatchId] = hash
+			}
+
+			for _, aura := range auras {
+				var targets []types.EntityID
+				if aura.Allies {
+					targets = FindAlliesInRadiusSpatialHash(hash, pos.PositionVectorX, pos.PositionVectorY, aura.Radius, team.Team)
+				} else {
+					targets = FindEnemiesInRadiusSpatialHash(hash, pos.PositionVectorX, pos.PositionVectorY, aura.Radius, team.Team)
+				}
+				for _, target := range targets {
+					modifiers[target] = append(modifiers[target], aura.StatModifier)
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error searching aura sources (stats.go/auraModifiers): %w", err)
+	}
+	return modifiers, nil
+}
+
+// auras of a unit or structure from the balance registries
+func auraDefinitions(name string) []Aura {
+	if unitType, ok := UnitRegistry[name]; ok {
+		return unitType.Auras
+	}
+	return StructureDataRegistry[name].Auras
+}
+
+// sets the effective stats of an entity from its base stats and modifiers
+func applyStats(world cardinal.WorldContext, id types.EntityID, mods []StatModifier) error {
+	base, err := cardinal.GetComponent[comp.BaseStats](world, id)
+	if err != nil {
+		return fmt.Errorf("error getting base stats (stats.go/applyStats): %w", err)
+	}
+
+	atk, err := cardinal.GetComponent[comp.Attack](world, id)
+	if err != nil {
+		return fmt.Errorf("error getting attack (stats.go/applyStats): %w", err)
+	}
+	stats := effectiveStats(base, atk.DamageFrame, mods)
+
+	//structures don't move and have no movespeed
+	if ms, err := cardinal.GetComponent[comp.Movespeed](world, id); err == nil {
+		if ms.CurrentMS != stats.movespeed {
+			ms.CurrentMS = stats.movespeed
+			if err := cardinal.SetComponent(world, id, ms); err != nil {
+				return fmt.Errorf("error setting movespeed (stats.go/applyStats): %w", err)
+			}
+		}
+	}
+
+	if atk.Damage != stats.damage || atk.Rate != stats.rate {
+		atk.Damage = stats.damage
+		atk.Rate = stats.rate
+		if err := cardinal.SetComponent(world, id, atk); err != nil {
+			return fmt.Errorf("error setting attack (stats.go/applyStats): %w", err)
+		}
+	}
+
+	if defense, err := cardinal.GetComponent[comp.Defense](world, id); err == nil {
+		if defense.DamageTaken != stats.damageTaken {
+			defense.DamageTaken = stats.damageTaken
+			if err := cardinal.SetComponent(world, id, defense); err != nil {
+				return fmt.Errorf("error setting defense (stats.go/applyStats): %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// stats of a unit or structure after its modifiers
+type unitStats struct {
+	movespeed   float32
+	damage      float32
+	rate        int
+	damageTaken float32
+}
+
+// stats after the modifiers, none go below 0 and the attack has to last until its damage frame or it never hits
+func effectiveStats(base *comp.BaseStats, damageFrame int, mods []StatModifier) unitStats {
+	rate := int(math.Round(float64(modifiedStat(float32(base.AttackRate), "AttackRate", mods))))
+	return unitStats{
+		movespeed:   float32(math.Max(0, float64(modifiedStat(base.Movespeed, "Movespeed", mods)))),
+		damage:      float32(math.Max(0, float64(modifiedStat(base.Damage, "Damage", mods)))),
+		rate:        max(rate, damageFrame, 1),
+		damageTaken: float32(math.Max(0, float64(modifiedStat(1, "DamageTaken", mods)))),
+	}
+}
+
+// base value of a stat after the modifiers for it, adds before mults
+func modifiedStat(base float32, stat string, mods []StatModifier) float32 {
+	add, mult := float32(0), float32(1)
+	for _, mod := range mods {
+		if mod.Stat != stat {
+			continue
+		}
+		add += mod.Add
+		if mod.Mult != 0 {
+			mult *= mod.Mult
+		}
+	}
+	return (base + add) * mult
+}
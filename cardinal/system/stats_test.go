@@ -0,0 +1,106 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestModifiedStat(t *testing.T) {
+	tests := []struct {
+		name string
+		base float32
+		stat string
+		mods []StatModifier
+		want float32
+	}{
+		{"no modifiers", 30, "Movespeed", nil, 30},
+		{"other stat ignored", 30, "Movespeed", []StatModifier{{Stat: "Damage", Add: 5, Mult: 2}}, 30},
+		{"add", 15, "Damage", []StatModifier{{Stat: "Damage", Add: 5}}, 20},
+		{"mult", 30, "Movespeed", []StatModifier{{Stat: "Movespeed", Mult: .5}}, 15},
+		{"adds before mults", 10, "Damage", []StatModifier{{Stat: "Damage", Mult: 2}, {Stat: "Damage", Add: 5}}, 30},
+		{"mults multiply", 100, "Movespeed", []StatModifier{{Stat: "Movespeed", Mult: .5}, {Stat: "Movespeed", Mult: .5}}, 25},
+		{"zero mult leaves stat unscaled", 20, "AttackRate", []StatModifier{{Stat: "AttackRate", Add: 4, Mult: 0}}, 24},
+		{"rage", 20, "AttackRate", EffectRegistry["Rage"].Modifiers, 16},
+		{"tower aura", 1, "DamageTaken", []StatModifier{{Stat: "DamageTaken", Mult: .85}}, .85},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := modifiedStat(tt.base, tt.stat, tt.mods); got != tt.want {
+				t.Errorf("modifiedStat(%v, %q, %v) = %v, want %v", tt.base, tt.stat, tt.mods, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEffectiveStats(t *testing.T) {
+	base := &comp.BaseStats{Movespeed: 100, Damage: 20, AttackRate: 20}
+	tests := []struct {
+		name        string
+		damageFrame int
+		mods        []StatModifier
+		want        unitStats
+	}{
+		{"base stats", 10, nil, unitStats{movespeed: 100, damage: 20, rate: 20, damageTaken: 1}},
+		{"slowed", 10, EffectRegistry["Slow"].Modifiers, unitStats{movespeed: 70, damage: 20, rate: 20, damageTaken: 1}},
+		{"enraged", 10, EffectRegistry["Rage"].Modifiers, unitStats{movespeed: 100, damage: 25, rate: 16, damageTaken: 1}},
+		{"rate rounds", 0, []StatModifier{{Stat: "AttackRate", Mult: .77}}, unitStats{movespeed: 100, damage: 20, rate: 15, damageTaken: 1}},
+		{"rate never beats the damage frame", 18, EffectRegistry["Rage"].Modifiers, unitStats{movespeed: 100, damage: 25, rate: 18, damageTaken: 1}},
+		{"rate is at least a tick", 0, []StatModifier{{Stat: "AttackRate", Add: -40}}, unitStats{movespeed: 100, damage: 20, rate: 1, damageTaken: 1}},
+		{"nothing goes negative", 10, []StatModifier{{Stat: "Movespeed", Add: -200}, {Stat: "Damage", Add: -30}, {Stat: "DamageTaken", Add: -2}},
+			unitStats{movespeed: 0, damage: 0, rate: 20, damageTaken: 0}},
+		{"protected", 10, []StatModifier{{Stat: "DamageTaken", Mult: .85}}, unitStats{movespeed: 100, damage: 20, rate: 20, damageTaken: .85}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := effectiveStats(base, tt.damageFrame, tt.mods); got != tt.want {
+				t.Errorf("effectiveStats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEffectModifiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		effects []comp.StatusEffect
+		want    []StatModifier
+	}{
+		{"no effects", nil, nil},
+		{"effect without modifiers", []comp.StatusEffect{{Name: "MageStun", Stacks: 1}}, nil},
+		{"one per stack", []comp.StatusEffect{{Name: "Slow", Stacks: 2}}, []StatModifier{{Stat: "Movespeed", Mult: .7}, {Stat: "Movespeed", Mult: .7}}},
+		{"every effect", []comp.StatusEffect{{Name: "Slow", Stacks: 1}, {Name: "Rage", Stacks: 1}},
+			append(append([]StatModifier{}, EffectRegistry["Slow"].Modifiers...), EffectRegistry["Rage"].Modifiers...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := effectModifiers(&comp.StatusEffects{Effects: tt.effects}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("effectModifiers() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuraDefinitions(t *testing.T) {
+	if err := LoadBalance(testBalancePath); err != nil {
+		t.Fatalf("LoadBalance() error = %v", err)
+	}
+	tests := []struct {
+		name string
+		unit string
+		want []Aura
+	}{
+		{"mage slows enemies", "Mage", []Aura{{Radius: 600, Allies: false, StatModifier: StatModifier{Stat: "Movespeed", Mult: .85}}}},
+		{"tower protects allies", "Tower", []Aura{{Radius: 1000, Allies: true, StatModifier: StatModifier{Stat: "DamageTaken", Mult: .85}}}},
+		{"unit without auras", "Vampire", nil},
+		{"unknown name", "Nothing", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := auraDefinitions(tt.unit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("auraDefinitions(%q) = %+v, want %+v", tt.unit, got, tt.want)
+			}
+		})
+	}
+}
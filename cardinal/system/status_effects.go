@@ -0,0 +1,262 @@
+package system
+
+import (
+	"fmt"
+	"slices"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// how applying an effect the unit already has behaves
+const (
+	StackRefresh     = "refresh"     //one instance, duration restarts
+	StackAdd         = "stack"       //one instance, duration restarts and stacks go up to MaxStacks
+	StackIndependent = "independent" //every application runs on its own
+)
+
+type EffectDefinition struct {
+	Duration    int //ticks (100ms tickrate, 25 = 2.5 seconds)
+	Stacking    string
+	MaxStacks   int
+	Dispellable bool
+	Buff        bool           //helps the unit, purges only strip dispellable buffs and cleanses only debuffs
+	Stun        bool           //unit can't move or attack
+	Modifiers   []StatModifier //applied once per stack by the stat system
+	Shield      float32        //damage absorbed for the effect's duration, granted again on every application
+	OnTick      func(world cardinal.WorldContext, id types.EntityID, effect *comp.StatusEffect) error
+}
+
+// every status effect a unit can have
+var EffectRegistry = map[string]EffectDefinition{
+	"MageStun": {
+		Duration:    25,
+		Stacking:    StackRefresh,
+		MaxStacks:   1,
+		Dispellable: true,
+		Stun:        true,
+	},
+	"HealSpiral": {
+		Duration:    25,
+		Stacking:    StackIndependent,
+		MaxStacks:   1,
+		Dispellable: false,
+		Buff:        true,
+		OnTick:      healOverTime(.8),
+	},
+	"Burn": {
+		Duration:    30,
+		Stacking:    StackAdd,
+		MaxStacks:   3,
+		Dispellable: true,
+		OnTick:      damageOverTime(.3, DamageFire),
+	},
+	"Slow": {
+		Duration:    30,
+		Stacking:    StackRefresh,
+		MaxStacks:   1,
+		Dispellable: true,
+		Modifiers:   []StatModifier{{Stat: "Movespeed", Mult: .7}},
+	},
+	"Rage": {
+		Duration:    50,
+		Stacking:    StackRefresh,
+		MaxStacks:   1,
+		Dispellable: true,
+		Buff:        true,
+		Modifiers:   []StatModifier{{Stat: "Damage", Mult: 1.25}, {Stat: "AttackRate", Mult: .8}},
+	},
+	"Barrier": {
+		Duration:    50,
+		Stacking:    StackRefresh,
+		MaxStacks:   1,
+		Dispellable: true,
+		Buff:        true,
+		Shield:      30,
+	},
+}
+
+// applies an effect from sourceID to a unit following its stacking rule. structures have no status effects and are immune
+func applyStatusEffect(world cardinal.WorldContext, sourceID, id types.EntityID, name string) error {
+	def, ok := EffectRegistry[name]
+	if !ok {
+		return fmt.Errorf("effect %s not found in registry (status_effects.go/applyStatusEffect)", name)
+	}
+	if _, err := cardinal.GetComponent[comp.UnitTag](world, id); err != nil {
+		return nil
+	}
+	source, err := damageSource(world, sourceID)
+	if err != nil {
+		return fmt.Errorf("(status_effects.go/applyStatusEffect): %w", err)
+	}
+	effects, err := cardinal.GetComponent[comp.StatusEffects](world, id)
+	if err != nil {
+		return fmt.Errorf("error getting status effects (status_effects.go/applyStatusEffect): %w", err)
+	}
+
+	addStatusEffect(effects, name, def, source)
+	if err := cardinal.SetComponent(world, id, effects); err != nil {
+		return fmt.Errorf("error setting status effects (status_effects.go/applyStatusEffect): %w", err)
+	}
+	if err := grantShield(world, id, name, def.Shield, def.Duration); err != nil {
+		return fmt.Errorf("(status_effects.go/applyStatusEffect): %w", err)
+	}
+	return nil
+}
+
+// adds an application of the effect to a unit's effects following the stacking rule of its definition
+func addStatusEffect(effects *comp.StatusEffects, name string, def EffectDefinition, source comp.Owner) {
+	if def.Stacking != StackIndependent {
+		for i := range effects.Effects {
+			if effects.Effects[i].Name != name {
+				continue
+			}
+			effects.Effects[i].RemainingTicks = def.Duration
+			effects.Effects[i].Source = source
+			if def.Stacking == StackAdd && effects.Effects[i].Stacks < def.MaxStacks {
+				effects.Effects[i].Stacks++
+			}
+			return
+		}
+	}
+	effects.Effects = append(effects.Effects, comp.StatusEffect{Name: name, RemainingTicks: def.Duration, Stacks: 1, Source: source})
+}
+
+// removes the dispellable buffs (a purge) or debuffs (a cleanse) from a unit along with the shields they granted.
+// structures have no status effects and are immune
+func dispelStatusEffects(world cardinal.WorldContext, id types.EntityID, buffs bool) error {
+	if _, err := cardinal.GetComponent[comp.UnitTag](world, id); err != nil {
+		return nil
+	}
+	effects, health, err := GetComponents2[comp.StatusEffects, comp.Health](world, id)
+	if err != nil {
+		return fmt.Errorf("(status_effects.go/dispelStatusEffects): %w", err)
+	}
+	dispelEffects(effects, health, buffs)
+	if err := SetComponents2(world, id, effects, health); err != nil {
+		return fmt.Errorf("(status_effects.go/dispelStatusEffects): %w", err)
+	}
+	return nil
+}
+
+// drops the dispellable effects that are buffs (buffs true) or debuffs and the shield layers they granted
+func dispelEffects(effects *comp.StatusEffects, health *comp.Health, buffs bool) {
+	dispelled := func(name string) bool {
+		def, ok := EffectRegistry[name]
+		return ok && def.Dispellable && def.Buff == buffs
+	}
+	effects.Effects = slices.DeleteFunc(effects.Effects, func(effect comp.StatusEffect) bool {
+		return dispelled(effect.Name)
+	})
+	health.Shields = slices.DeleteFunc(health.Shields, func(layer comp.ShieldLayer) bool {
+		return dispelled(layer.Source)
+	})
+}
+
+// runs the tick callback of every active effect and drops the ones that ran out
+func StatusEffectSystem(world cardinal.WorldContext) error {
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
+
+	return cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.StatusEffects]())).
+		Where(activeFilter).
+		Each(world, func(id types.EntityID) bool {
+			effects, err := cardinal.GetComponent[comp.StatusEffects](world, id)
+			if err != nil {
+				fmt.Printf("error getting status effects (status_effects.go): %v \n", err)
+				return false
+			}
+			if len(effects.Effects) == 0 {
+				return true
+			}
+
+			tickStatusEffects(effects, func(def EffectDefinition, effect *comp.StatusEffect) {
+				if def.OnTick == nil {
+					return
+				}
+				if err := def.OnTick(world, id, effect); err != nil {
+					fmt.Printf("error on %s tick (status_effects.go): %v \n", effect.Name, err)
+				}
+			})
+
+			if err := cardinal.SetComponent(world, id, effects); err != nil {
+				fmt.Printf("error setting status effects (status_effects.go): %v \n", err)
+				return false
+			}
+			return true
+		})
+}
+
+// runs onTick for every effect, counts them down and drops the ones that ran out and the ones not in the registry
+func tickStatusEffects(effects *comp.StatusEffects, onTick func(def EffectDefinition, effect *comp.StatusEffect)) {
+	kept := make([]comp.StatusEffect, 0, len(effects.Effects))
+	for _, effect := range effects.Effects {
+		def, ok := EffectRegistry[effect.Name]
+		if !ok {
+			fmt.Printf("effect %s not found in registry (status_effects.go) \n", effect.Name)
+			continue
+		}
+		onTick(def, &effect)
+		effect.RemainingTicks--
+		if effect.RemainingTicks > 0 {
+			kept = append(kept, effect)
+		}
+	}
+	effects.Effects = kept
+}
+
+// heals the unit by amount per stack every tick, capped at max hp
+func healOverTime(amount float32) func(cardinal.WorldContext, types.EntityID, *comp.StatusEffect) error {
+	return func(world cardinal.WorldContext, id types.EntityID, effect *comp.StatusEffect) error {
+		return cardinal.UpdateComponent(world, id, func(health *comp.Health) *comp.Health {
+			if health == nil {
+				fmt.Printf("error retrieving health component (status_effects.go/healOverTime) \n")
+				return nil
+			}
+			if health.CurrentHP == 0 { // do not heal because unit will never die if its always healing at 0
+				return health
+			}
+			health.CurrentHP += amount * float32(effect.Stacks)
+			if health.CurrentHP > health.MaxHP {
+				health.CurrentHP = health.MaxHP
+			}
+			return health
+		})
+	}
+}
+
+// deals amount of damageType per stack every tick, credited to whoever applied the effect
+func damageOverTime(amount float32, damageType string) func(cardinal.WorldContext, types.EntityID, *comp.StatusEffect) error {
+	return func(world cardinal.WorldContext, id types.EntityID, effect *comp.StatusEffect) error {
+		if _, err := resolveSourceDamage(world, effect.Source, id, amount*float32(effect.Stacks), damageType); err != nil {
+			return fmt.Errorf("(status_effects.go/damageOverTime): %w", err)
+		}
+		return nil
+	}
+}
+
+// Stunned returns true if one of the effects stuns the unit
+func Stunned(effects *comp.StatusEffects) bool {
+	for _, effect := range effects.Effects {
+		if EffectRegistry[effect.Name].Stun {
+			return true
+		}
+	}
+	return false
+}
+
+// true if the unit is stunned, units without status effects never are
+func stunned(world cardinal.WorldContext, id types.EntityID) bool {
+	effects, err := cardinal.GetComponent[comp.StatusEffects](world, id)
+	if err != nil {
+		return false
+	}
+	return Stunned(effects)
+}
@@ -0,0 +1,160 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func effectNames(effects []comp.StatusEffect) []string {
+	names := []string{}
+	for _, effect := range effects {
+		names = append(names, effect.Name)
+	}
+	return names
+}
+
+func layerSources(layers []comp.ShieldLayer) []string {
+	sources := []string{}
+	for _, layer := range layers {
+		sources = append(sources, layer.Source)
+	}
+	return sources
+}
+
+func TestDispelEffects(t *testing.T) {
+	effects := []string{"MageStun", "HealSpiral", "Burn", "Slow", "Rage", "Barrier"}
+	tests := []struct {
+		name        string
+		buffs       bool
+		wantEffects []string
+		wantShields []string
+	}{
+		//HealSpiral is a buff but not dispellable
+		{"purge strips dispellable buffs", true, []string{"MageStun", "HealSpiral", "Burn", "Slow"}, []string{"Tower"}},
+		{"cleanse strips debuffs", false, []string{"HealSpiral", "Rage", "Barrier"}, []string{"Barrier", "Tower"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &comp.StatusEffects{}
+			for _, name := range effects {
+				status.Effects = append(status.Effects, comp.StatusEffect{Name: name, RemainingTicks: 10, Stacks: 1})
+			}
+			//a shield from something that isn't an effect is never dispelled
+			health := &comp.Health{CurrentHP: 100, MaxHP: 100, Shields: []comp.ShieldLayer{
+				{Source: "Barrier", Amount: 30, RemainingTicks: 10},
+				{Source: "Tower", Amount: 10, RemainingTicks: 10},
+			}}
+			dispelEffects(status, health, tt.buffs)
+			if got := effectNames(status.Effects); !reflect.DeepEqual(got, tt.wantEffects) {
+				t.Errorf("effects = %v, want %v", got, tt.wantEffects)
+			}
+			if got := layerSources(health.Shields); !reflect.DeepEqual(got, tt.wantShields) {
+				t.Errorf("shields = %v, want %v", got, tt.wantShields)
+			}
+		})
+	}
+}
+
+func TestAddStatusEffect(t *testing.T) {
+	mage := comp.Owner{UnitName: "Mage", Team: "Blue"}
+	spirit := comp.Owner{UnitName: "FireSpirit", Team: "Blue"}
+	tests := []struct {
+		name    string
+		effect  string
+		applies int
+		want    []comp.StatusEffect
+	}{
+		{"first application", "MageStun", 1,
+			[]comp.StatusEffect{{Name: "MageStun", RemainingTicks: 25, Stacks: 1, Source: mage}}},
+		{"refresh keeps one instance", "MageStun", 3,
+			[]comp.StatusEffect{{Name: "MageStun", RemainingTicks: 25, Stacks: 1, Source: spirit}}},
+		{"stack adds stacks", "Burn", 2,
+			[]comp.StatusEffect{{Name: "Burn", RemainingTicks: 30, Stacks: 2, Source: spirit}}},
+		{"stacks stop at max stacks", "Burn", 5,
+			[]comp.StatusEffect{{Name: "Burn", RemainingTicks: 30, Stacks: 3, Source: spirit}}},
+		{"independent applications run on their own", "HealSpiral", 3, []comp.StatusEffect{
+			{Name: "HealSpiral", RemainingTicks: 15, Stacks: 1, Source: mage},
+			{Name: "HealSpiral", RemainingTicks: 25, Stacks: 1, Source: spirit},
+			{Name: "HealSpiral", RemainingTicks: 25, Stacks: 1, Source: spirit},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			effects := &comp.StatusEffects{}
+			def := EffectRegistry[tt.effect]
+			//the first application is the mage's, the rest the spirit's
+			addStatusEffect(effects, tt.effect, def, mage)
+			for i := 1; i < tt.applies; i++ {
+				//let the first application run a little so a refresh is visible
+				effects.Effects[0].RemainingTicks -= 5
+				addStatusEffect(effects, tt.effect, def, spirit)
+			}
+			if !reflect.DeepEqual(effects.Effects, tt.want) {
+				t.Errorf("effects = %+v, want %+v", effects.Effects, tt.want)
+			}
+		})
+	}
+
+	//other effects are left alone
+	effects := &comp.StatusEffects{Effects: []comp.StatusEffect{{Name: "Slow", RemainingTicks: 4, Stacks: 1}}}
+	addStatusEffect(effects, "Burn", EffectRegistry["Burn"], spirit)
+	if got := effectNames(effects.Effects); !reflect.DeepEqual(got, []string{"Slow", "Burn"}) || effects.Effects[0].RemainingTicks != 4 {
+		t.Errorf("effects = %+v, want Slow untouched and Burn added", effects.Effects)
+	}
+}
+
+func TestTickStatusEffects(t *testing.T) {
+	effects := &comp.StatusEffects{Effects: []comp.StatusEffect{
+		{Name: "MageStun", RemainingTicks: 3, Stacks: 1},
+		{Name: "Burn", RemainingTicks: 1, Stacks: 2},
+		{Name: "Removed", RemainingTicks: 10, Stacks: 1},
+		{Name: "HealSpiral", RemainingTicks: 2, Stacks: 1},
+	}}
+	ticked := make(map[string]int)
+	tick := func(def EffectDefinition, effect *comp.StatusEffect) {
+		ticked[effect.Name]++
+	}
+
+	want := [][]string{
+		{"MageStun", "HealSpiral"},
+		{"MageStun"},
+		{},
+	}
+	for i, names := range want {
+		tickStatusEffects(effects, tick)
+		if got := effectNames(effects.Effects); !reflect.DeepEqual(got, names) {
+			t.Fatalf("after tick %d effects = %v, want %v", i+1, got, names)
+		}
+	}
+	//every effect ticks once per remaining tick, including the one it runs out on. unknown effects never tick
+	wantTicked := map[string]int{"MageStun": 3, "Burn": 1, "HealSpiral": 2}
+	if !reflect.DeepEqual(ticked, wantTicked) {
+		t.Errorf("ticks = %v, want %v", ticked, wantTicked)
+	}
+}
+
+func TestStunned(t *testing.T) {
+	tests := []struct {
+		name    string
+		effects []string
+		want    bool
+	}{
+		{"no effects", nil, false},
+		{"stun", []string{"MageStun"}, true},
+		{"stun among others", []string{"Slow", "MageStun", "Burn"}, true},
+		{"slowed is not stunned", []string{"Slow", "Burn"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			effects := &comp.StatusEffects{}
+			for _, name := range tt.effects {
+				effects.Effects = append(effects.Effects, comp.StatusEffect{Name: name, RemainingTicks: 10, Stacks: 1})
+			}
+			if got := Stunned(effects); got != tt.want {
+				t.Errorf("Stunned(%v) = %v, want %v", tt.effects, got, tt.want)
+			}
+		})
+	}
+}
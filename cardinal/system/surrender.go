@@ -0,0 +1,55 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+)
+
+// ends a match when a player concedes, the opponent is credited with the win
+func (r Rules) SurrenderSystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(world,
+		func(surrender cardinal.TxData[msg.SurrenderMsg]) (msg.SurrenderResult, error) {
+			gameState, err := getGameStateGSS(world, &comp.MatchId{MatchId: surrender.Msg.MatchID})
+			if err != nil {
+				return msg.SurrenderResult{Success: false}, fmt.Errorf("match has not started (surrender.go): %w", ErrMatchNotFound)
+			}
+
+			timer, err := cardinal.GetComponent[comp.MatchTimer](world, gameState)
+			if err != nil {
+				return msg.SurrenderResult{Success: false}, fmt.Errorf("error getting match timer (surrender.go): %w", err)
+			}
+			//only the player on the surrendering team can concede for it
+			team, err := authorizeTeam(world, gameState, surrender.Tx.PersonaTag, surrender.Msg.Team)
+			if err != nil {
+				return msg.SurrenderResult{Success: false}, fmt.Errorf("(surrender.go): %w", err)
+			}
+			opponent, err := surrenderWinner(timer, surrender.Msg.MatchID, team)
+			if err != nil {
+				return msg.SurrenderResult{Success: false}, err
+			}
+
+			err = recordReplayInput(world, surrender.Msg.MatchID, "surrender", surrender.Tx.PersonaTag, r.recordedTxHash(surrender.Hash), surrender.Msg)
+			if err != nil {
+				return msg.SurrenderResult{Success: false}, fmt.Errorf("(surrender.go): %w", err)
+			}
+			if err := endMatch(world, surrender.Msg.MatchID, opponent, "surrender"); err != nil {
+				return msg.SurrenderResult{Success: false}, fmt.Errorf("error ending match (surrender.go): %w", err)
+			}
+			return msg.SurrenderResult{Success: true}, nil
+		})
+}
+
+// team credited with the win when team concedes, a match that already ended can't be conceded
+func surrenderWinner(timer *comp.MatchTimer, matchID, team string) (string, error) {
+	if timer.Phase == "Ended" {
+		return "", fmt.Errorf("%w (surrender.go): %s", ErrMatchEnded, matchID)
+	}
+	if team == "Blue" {
+		return "Red", nil
+	}
+	return "Blue", nil
+}
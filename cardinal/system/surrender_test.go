@@ -0,0 +1,34 @@
+package system
+
+import (
+	"errors"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestSurrenderWinner(t *testing.T) {
+	tests := []struct {
+		name    string
+		phase   string
+		team    string
+		want    string
+		wantErr error
+	}{
+		{"blue concedes", "Regulation", "Blue", "Red", nil},
+		{"red concedes in overtime", "Overtime", "Red", "Blue", nil},
+		{"red concedes in sudden death", "SuddenDeath", "Red", "Blue", nil},
+		{"match already ended", "Ended", "Blue", "", ErrMatchEnded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := surrenderWinner(&comp.MatchTimer{Phase: tt.phase}, "m", tt.team)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("surrenderWinner(%s, %s) error = %v, want %v", tt.phase, tt.team, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("surrenderWinner(%s, %s) = %q, want %q", tt.phase, tt.team, got, tt.want)
+			}
+		})
+	}
+}
@@ -33,7 +33,7 @@ func UnitMovementSystem(world cardinal.WorldContext) error {
 			continue
 		}
 
-		if cc.Stun > 0 || cc.KnockBack { //if unit stunned cannot move
+		if stunned(world, id) || cc.KnockBack { //if unit stunned cannot move
 			continue
 		}
 
@@ -111,7 +111,7 @@ func UnitMovementSystem(world cardinal.WorldContext) error {
 					//move towards enemy
 					uPos = moveUnitTowardsEnemy(uPos, ePos.PositionVectorX, ePos.PositionVectorY, eRadius.UnitRadius, uMs.CurrentMS, uRadius.UnitRadius)
 					//check that unit isnt walking through out of bounds towards a found unit
-					exists := moveDirectionExsist(uPos.PositionVectorX, uPos.PositionVectorY, mapName.MapName)
+					exists := moveDirectionExsist(uPos.PositionVectorX, uPos.PositionVectorY, mapName.MapName, class.Class)
 					if exists {
 						//attempt to push blocking units
 						pushBlockingUnit(world, collisionHash, id, uPos.PositionVectorX, uPos.PositionVectorY, uRadius.UnitRadius, uTeam.Team, class.Class, uMs.CurrentMS, mapName)
@@ -189,7 +189,7 @@ func UnitMovementSystem(world cardinal.WorldContext) error {
 						tempY := uPos.PositionVectorY
 						uPos = moveUnitTowardsEnemy(uPos, eX, eY, eRadius, uMs.CurrentMS, uRadius.UnitRadius)
 						//check that unit isnt walking through out of bounds towards a found unit
-						exists := moveDirectionExsist(uPos.PositionVectorX, uPos.PositionVectorY, mapName.MapName)
+						exists := moveDirectionExsist(uPos.PositionVectorX, uPos.PositionVectorY, mapName.MapName, class.Class)
 						if exists {
 							//attempt to push blocking units
 							pushBlockingUnit(world, collisionHash, id, uPos.PositionVectorX, uPos.PositionVectorY, uRadius.UnitRadius, uTeam.Team, class.Class, uMs.CurrentMS, mapName)
@@ -220,7 +220,7 @@ func UnitMovementSystem(world cardinal.WorldContext) error {
 					// //Store Original X and Y
 					tempX := uPos.PositionVectorX
 					tempY := uPos.PositionVectorY
-					uPos, err = moveUnitDirectionMap(uPos, uTeam, uMs.CurrentMS, mapName)
+					uPos, err = moveUnitDirectionMap(uPos, uTeam, class, uMs.CurrentMS, mapName)
 					if err != nil {
 						fmt.Printf("(unit_movement.go): %v \n", err)
 						continue
@@ -268,9 +268,15 @@ func priorityUnitMovement(world cardinal.WorldContext) ([]types.EntityID, error)
 		Distance float32
 	}
 
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return nil, err
+	}
+
 	// Search all units
 	unitList, err := cardinal.NewSearch().Entity(
-		filter.Contains(filter.Component[comp.UnitTag]())).Collect(world)
+		filter.Contains(filter.Component[comp.UnitTag]())).Where(activeFilter).Collect(world)
 	if err != nil {
 		return nil, fmt.Errorf("PriorityUnitMovement error searching for unit with map (priorityUnitMovement): %w", err)
 	}
@@ -302,31 +308,24 @@ func priorityUnitMovement(world cardinal.WorldContext) ([]types.EntityID, error)
 }
 
 // Moves Unit in direction of the map Direction vector
-func moveUnitDirectionMap(position *comp.Position, team *comp.Team, movespeed float32, mapName *comp.MapName) (*comp.Position, error) {
+func moveUnitDirectionMap(position *comp.Position, team *comp.Team, class *comp.Class, movespeed float32, mapName *comp.MapName) (*comp.Position, error) {
 
 	//check if mapName exsists and if direction vector exsists at (x, y) location
-	directionVector, err := getMapDirection(position.PositionVectorX, position.PositionVectorY, mapName.MapName)
+	directionVector, err := getMapDirection(position.PositionVectorX, position.PositionVectorY, mapName.MapName, team.Team, class.Class)
 	if err != nil {
 		return nil, fmt.Errorf("(MoveUnitDirectionMap): %w", err)
 	}
 
-	var dirX, dirY float32
-	//updated rotation based on team
-	if team.Team == "Blue" {
-		dirX = directionVector[0]
-		dirY = directionVector[1]
-	} else {
-		dirX = directionVector[0] * -1 //reverse direction for red
-		dirY = directionVector[1] * -1
-	}
+	dirX := directionVector[0]
+	dirY := directionVector[1]
 	//if unit walking out of bounds find in bounds rotation
-	findInboundsRotation(5, 180, position, dirX, dirY, movespeed, mapName)
+	findInboundsRotation(5, 180, position, dirX, dirY, movespeed, mapName, class.Class)
 
 	return position, nil
 }
 
 // checks alternation left and right rotations until finds inbounds location
-func findInboundsRotation(angle, rotation float64, position *comp.Position, dirX, dirY, movespeed float32, mapName *comp.MapName) {
+func findInboundsRotation(angle, rotation float64, position *comp.Position, dirX, dirY, movespeed float32, mapName *comp.MapName, class string) {
 	//update new x,y based on movespeed
 	tempX := position.PositionVectorX + (dirX * movespeed)
 	tempY := position.PositionVectorY + (dirY * movespeed)
@@ -336,7 +335,7 @@ func findInboundsRotation(angle, rotation float64, position *comp.Position, dirX
 	sumAngle := 0.0 // Sum of rotated angles
 
 	for i := 0; ; i++ {
-		if moveDirectionExsist(tempX, tempY, mapName.MapName) {
+		if moveDirectionExsist(tempX, tempY, mapName.MapName, class) {
 			//update new x,y based on movespeed
 			position.PositionVectorX = tempX
 			position.PositionVectorY = tempY
@@ -531,7 +530,7 @@ func pushFromPtBtoA(world cardinal.WorldContext, hash *comp.SpatialHash, id type
 			//move unit towards even outside radius
 			test := moveUnitTowardsEnemy(&comp.Position{PositionVectorX: testX, PositionVectorY: testY}, targetPos.PositionVectorX, targetPos.PositionVectorY, targetRadius.UnitRadius, length, radius)
 			// Check if the position is free of collisions
-			if !CheckCollisionSpatialHash(hash, test.PositionVectorX, test.PositionVectorY, radius, class.Class, true) && moveDirectionExsist(test.PositionVectorX, test.PositionVectorY, mapName.MapName) {
+			if !CheckCollisionSpatialHash(hash, test.PositionVectorX, test.PositionVectorY, radius, class.Class, true) && moveDirectionExsist(test.PositionVectorX, test.PositionVectorY, mapName.MapName, class.Class) {
 				return test.PositionVectorX, test.PositionVectorY // Return the first free spot found
 			}
 		}
@@ -542,7 +541,7 @@ func pushFromPtBtoA(world cardinal.WorldContext, hash *comp.SpatialHash, id type
 			testX := targetX + dirX*float32(d) // Start from target position
 			testY := targetY + dirY*float32(d) // Start from target position
 			// Check if the position is free of collisions
-			if !CheckCollisionSpatialHash(hash, testX, testY, radius, class.Class, true) && moveDirectionExsist(testX, testY, mapName.MapName) {
+			if !CheckCollisionSpatialHash(hash, testX, testY, radius, class.Class, true) && moveDirectionExsist(testX, testY, mapName.MapName, class.Class) {
 				return testX, testY // Return the first free spot found
 			}
 		}
@@ -600,7 +599,7 @@ func moveToNearestFreeSpaceBox(hash *comp.SpatialHash, startX, startY, targetX,
 				testY := startY + dirY*d + perpY*float32(w)*step
 
 				// Check if the position is free of collisions
-				if !CheckCollisionSpatialHash(hash, testX, testY, int(radius), class, true) && moveDirectionExsist(testX, testY, mapName.MapName) {
+				if !CheckCollisionSpatialHash(hash, testX, testY, int(radius), class, true) && moveDirectionExsist(testX, testY, mapName.MapName, class) {
 					return testX, testY
 				}
 			}
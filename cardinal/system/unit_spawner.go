@@ -2,6 +2,7 @@ package system
 
 import (
 	"fmt"
+	"maps"
 
 	"pkg.world.dev/world-engine/cardinal"
 	"pkg.world.dev/world-engine/cardinal/iterators"
@@ -14,7 +15,7 @@ import (
 
 // Spawns player units
 // called from create_unit.go msg
-func UnitSpawnerSystem(world cardinal.WorldContext) error {
+func (r Rules) UnitSpawnerSystem(world cardinal.WorldContext) error {
 	return cardinal.EachMessage(world,
 		func(create cardinal.TxData[msg.CreateUnitMsg]) (msg.CreateUnitResult, error) {
 			//create filter for matching ID's
@@ -23,125 +24,198 @@ func UnitSpawnerSystem(world cardinal.WorldContext) error {
 			})
 			//get game state
 			gameState, err := cardinal.NewSearch().Entity(
-				filter.Exact(GameStateFilters())).
+				filter.Exact(GameStateFilters()...)).
 				Where(matchFilter).First(world)
 			if err != nil {
 				return msg.CreateUnitResult{Success: false}, fmt.Errorf("error searching for match (unit_spawner.go): %w", err)
 			}
 			if gameState == iterators.BadID { // Assuming cardinal.NoEntity represents no result found
-				return msg.CreateUnitResult{Success: false}, fmt.Errorf("no match found with ID or missing components (unit_spawner.go): %s", create.Msg.MatchID)
+				return msg.CreateUnitResult{Success: false}, fmt.Errorf("%w (unit_spawner.go): %s", ErrMatchNotFound, create.Msg.MatchID)
 			}
 
-			//get unit data
-			unitType, spType, err := getUnitData(create.Msg.UnitType)
+			//the team comes from the senders persona, not the message
+			team, err := authorizeTeam(world, gameState, create.Tx.PersonaTag, create.Msg.Team)
 			if err != nil {
 				return msg.CreateUnitResult{Success: false}, fmt.Errorf("(unit_spawner.go): %w", err)
 			}
 
-			//check if mapName exsists and if direction vector exsists at (x, y) location
-			if !moveDirectionExsist(create.Msg.PositionX, create.Msg.PositionY, create.Msg.MapName) {
-				return msg.CreateUnitResult{Success: false}, fmt.Errorf("map name or direction vector does not exsist for location")
-			}
-
-			mapData, exists := MapDataRegistry[create.Msg.MapName]
-			if !exists {
-				return msg.CreateUnitResult{Success: false}, fmt.Errorf("error key for MapDataRegistry does not exsist (unit_spawner.go)")
+			//units are spawned on the map the match is played on
+			matchMap, err := cardinal.GetComponent[comp.MapName](world, gameState)
+			if err != nil {
+				return msg.CreateUnitResult{Success: false}, fmt.Errorf("error getting map name component (unit_spawner.go): %w", err)
 			}
-
-			//calculate distance from enemy spawn
-			var tempDistance float32
-			if create.Msg.Team == "Blue" {
-				tempDistance = distanceBetweenTwoPoints(float32(mapData.Bases[1][0]), float32(mapData.Bases[1][1]), create.Msg.PositionX, create.Msg.PositionY)
-			} else {
-				tempDistance = distanceBetweenTwoPoints(float32(mapData.Bases[0][0]), float32(mapData.Bases[0][1]), create.Msg.PositionX, create.Msg.PositionY)
+			mapName := matchMap.MapName
+			if create.Msg.MapName != "" && create.Msg.MapName != mapName {
+				return msg.CreateUnitResult{Success: false}, fmt.Errorf("%w (unit_spawner.go): %s", ErrMapMismatch, create.Msg.MapName)
 			}
 
-			//get collision Hash component from game state
-			SpatialHash, err := cardinal.GetComponent[comp.SpatialHash](world, gameState)
+			//no new units once the match has ended
+			timer, err := cardinal.GetComponent[comp.MatchTimer](world, gameState)
 			if err != nil {
-				return msg.CreateUnitResult{Success: false}, fmt.Errorf("error getting SpatialHash component (unit_spawner.go): %w", err)
+				return msg.CreateUnitResult{Success: false}, fmt.Errorf("error getting match timer (unit_spawner.go): %w", err)
 			}
-			//check if spawning on a taken spot in collision hash
-			if CheckCollisionSpatialHash(SpatialHash, create.Msg.PositionX, create.Msg.PositionY, unitType.Radius, unitType.Class, true) {
-				return msg.CreateUnitResult{Success: false}, fmt.Errorf("collision with unit (unit_spawner.go)")
+			if timer.Phase == "Ended" {
+				return msg.CreateUnitResult{Success: false}, fmt.Errorf("%w (unit_spawner.go): %s", ErrMatchEnded, create.Msg.MatchID)
 			}
 
-			err = handLogic(world, gameState, create.Msg.UnitType, create.Msg.Team, unitType.Cost, create.Msg.UID)
-			if err != nil {
-				return msg.CreateUnitResult{Success: false}, fmt.Errorf("(unit_spawner.go) - %w", err)
+			if err := spawnUnitUS(world, gameState, mapName, team, &create.Msg, true); err != nil {
+				return msg.CreateUnitResult{Success: false}, err
 			}
 
-			//get new UID
-			UID, err := getNextUID(world, create.Msg.MatchID)
+			err = recordReplayInput(world, create.Msg.MatchID, "create-unit", create.Tx.PersonaTag, r.recordedTxHash(create.Hash), create.Msg)
 			if err != nil {
 				return msg.CreateUnitResult{Success: false}, fmt.Errorf("(unit_spawner.go) - %w", err)
 			}
 
-			zOffSet := create.Msg.PositionZ
-			if unitType.Class == "air" {
-				zOffSet += 450
-			}
+			return msg.CreateUnitResult{Success: true}, nil
+		})
+}
 
-			//create unit
-			entityID, err := cardinal.Create(world,
-				comp.MatchId{MatchId: create.Msg.MatchID},
-				comp.UID{UID: UID},
-				comp.UnitName{UnitName: create.Msg.UnitType},
-				comp.Team{Team: create.Msg.Team},
-				comp.Health{CurrentHP: unitType.Health, MaxHP: unitType.Health},
-				comp.Movespeed{CurrentMS: unitType.Speed},
-				comp.Position{PositionVectorX: create.Msg.PositionX, PositionVectorY: create.Msg.PositionY, PositionVectorZ: zOffSet, RotationVectorX: create.Msg.RotationX, RotationVectorY: create.Msg.RotationY, RotationVectorZ: create.Msg.RotationZ},
-				comp.MapName{MapName: create.Msg.MapName},
-				comp.Distance{Distance: tempDistance},
-				comp.Class{Class: unitType.Class},
-				//comp.Destroyed{Destroyed: false},
-				comp.UnitRadius{UnitRadius: unitType.Radius},
-				comp.Attack{
-					Combat:       false,
-					Damage:       unitType.Damage,
-					Rate:         unitType.AttackRate,
-					Frame:        0,
-					DamageFrame:  unitType.DamageFrame,
-					AttackRadius: unitType.AttackRadius,
-					AggroRadius:  unitType.AggroRadius,
-					State:        "Default",
-				},
-				comp.Sp{
-					DmgSp:               unitType.DmgSp,
-					SpRate:              unitType.SpRate,
-					CurrentSp:           unitType.CurrentSP,
-					MaxSp:               unitType.MaxSP,
-					Charged:             false,
-					Rate:                spType.AttackRate,
-					DamageFrame:         spType.DamageFrame,
-					DamageEndFrame:      spType.DamageEndFrame,
-					StructureTargetable: spType.StructureTargetable,
-					Combat:              false,
-					AttackRadius:        spType.AttackRadius,
-				},
-				comp.CenterOffset{CenterOffset: unitType.CenterOffset},
-				comp.CC{Stun: 0, KnockBack: false},
-				comp.EffectsList{EffectsList: make(map[string]int)},
-				comp.UnitTag{},
-			)
-			if err != nil {
-				return msg.CreateUnitResult{Success: false}, fmt.Errorf("error creating unit (unit_spawner.go): %w", err)
-			}
+// places a unit for team in a started match. playCard takes the card from the team's hand and pays for it,
+// scripted spawns (cmd/simulate) skip it
+func spawnUnitUS(world cardinal.WorldContext, gameState types.EntityID, mapName, team string, create *msg.CreateUnitMsg, playCard bool) error {
+	//get unit data
+	unitType, spType, err := getUnitData(create.UnitType)
+	if err != nil {
+		return fmt.Errorf("(unit_spawner.go): %w", err)
+	}
 
-			//add unit to collision hash collision map
-			AddObjectSpatialHash(SpatialHash, entityID, create.Msg.PositionX, create.Msg.PositionY, unitType.Radius, create.Msg.Team, unitType.Class)
+	//check if mapName exsists and if direction vector exsists at (x, y) location
+	if !moveDirectionExsist(create.PositionX, create.PositionY, mapName, unitType.Class) {
+		return fmt.Errorf("map name or direction vector does not exsist for location")
+	}
 
-			err = cardinal.SetComponent(world, gameState, SpatialHash)
-			if err != nil {
-				return msg.CreateUnitResult{Success: false}, fmt.Errorf("error setting hash component (unit_spawner.go): %w", err)
-			}
+	mapData, exists := MapDataRegistry[mapName]
+	if !exists {
+		return fmt.Errorf("error key for MapDataRegistry does not exsist (unit_spawner.go)")
+	}
 
-			return msg.CreateUnitResult{Success: true}, nil
-		})
+	//calculate distance from enemy spawn
+	var tempDistance float32
+	if team == "Blue" {
+		tempDistance = distanceBetweenTwoPoints(float32(mapData.Bases[1][0]), float32(mapData.Bases[1][1]), create.PositionX, create.PositionY)
+	} else {
+		tempDistance = distanceBetweenTwoPoints(float32(mapData.Bases[0][0]), float32(mapData.Bases[0][1]), create.PositionX, create.PositionY)
+	}
+
+	//get collision Hash component from game state
+	SpatialHash, err := cardinal.GetComponent[comp.SpatialHash](world, gameState)
+	if err != nil {
+		return fmt.Errorf("error getting SpatialHash component (unit_spawner.go): %w", err)
+	}
+	//check if spawning on a taken spot in collision hash
+	if CheckCollisionSpatialHash(SpatialHash, create.PositionX, create.PositionY, unitType.Radius, unitType.Class, true) {
+		return fmt.Errorf("collision with unit (unit_spawner.go)")
+	}
+
+	if playCard {
+		gold, err := handLogic(world, gameState, create.UnitType, team, unitType.Cost)
+		if err != nil {
+			return fmt.Errorf("(unit_spawner.go) - %w", err)
+		}
+		if err = emitMatchEvent(world, create.MatchID, comp.MatchEvent{Type: "gold", Team: team, Value: gold}); err != nil {
+			return fmt.Errorf("(unit_spawner.go) - %w", err)
+		}
+		if err = recordCardPlayed(world, gameState, team, create.UnitType, unitType.Cost); err != nil {
+			return fmt.Errorf("(unit_spawner.go) - %w", err)
+		}
+	}
+
+	//get new UID
+	UID, err := getNextUID(world, create.MatchID)
+	if err != nil {
+		return fmt.Errorf("(unit_spawner.go) - %w", err)
+	}
+
+	zOffSet := create.PositionZ
+	if unitType.Class == "air" {
+		zOffSet += 450
+	}
+
+	//create unit
+	entityID, err := cardinal.Create(world,
+		comp.MatchId{MatchId: create.MatchID},
+		comp.UID{UID: UID},
+		comp.UnitName{UnitName: create.UnitType},
+		comp.Team{Team: team},
+		comp.Health{CurrentHP: unitType.Health, MaxHP: unitType.Health},
+		comp.Movespeed{CurrentMS: unitType.Speed},
+		comp.Position{PositionVectorX: create.PositionX, PositionVectorY: create.PositionY, PositionVectorZ: zOffSet, RotationVectorX: create.RotationX, RotationVectorY: create.RotationY, RotationVectorZ: create.RotationZ},
+		comp.MapName{MapName: mapName},
+		comp.Distance{Distance: tempDistance},
+		comp.Class{Class: unitType.Class},
+		//comp.Destroyed{Destroyed: false},
+		comp.UnitRadius{UnitRadius: unitType.Radius},
+		comp.SightRadius{SightRadius: unitType.SightRadius},
+		comp.BaseStats{Movespeed: unitType.Speed, Damage: unitType.Damage, AttackRate: unitType.AttackRate},
+		comp.Defense{Resistances: maps.Clone(unitType.Resistances), DamageTaken: 1},
+		comp.Attack{
+			Combat:       false,
+			Damage:       unitType.Damage,
+			DamageType:   unitType.DamageType,
+			Rate:         unitType.AttackRate,
+			Frame:        0,
+			DamageFrame:  unitType.DamageFrame,
+			AttackRadius: unitType.AttackRadius,
+			AggroRadius:  unitType.AggroRadius,
+			State:        "Default",
+		},
+		comp.Sp{
+			DmgSp:               unitType.DmgSp,
+			SpRate:              unitType.SpRate,
+			CurrentSp:           unitType.CurrentSP,
+			MaxSp:               unitType.MaxSP,
+			Charged:             false,
+			Rate:                spType.AttackRate,
+			DamageFrame:         spType.DamageFrame,
+			DamageEndFrame:      spType.DamageEndFrame,
+			StructureTargetable: spType.StructureTargetable,
+			Combat:              false,
+			AttackRadius:        spType.AttackRadius,
+		},
+		comp.CenterOffset{CenterOffset: unitType.CenterOffset},
+		comp.CC{KnockBack: false},
+		comp.StatusEffects{Effects: []comp.StatusEffect{}},
+		comp.UnitTag{},
+	)
+	if err != nil {
+		return fmt.Errorf("error creating unit (unit_spawner.go): %w", err)
+	}
+
+	//add unit to collision hash collision map
+	AddObjectSpatialHash(SpatialHash, entityID, create.PositionX, create.PositionY, unitType.Radius, team, unitType.Class)
+
+	err = cardinal.SetComponent(world, gameState, SpatialHash)
+	if err != nil {
+		return fmt.Errorf("error setting hash component (unit_spawner.go): %w", err)
+	}
+
+	//a played card carries the uid the client was holding it under so it can swap its placeholder for the unit
+	spawned := comp.MatchEvent{Type: "spawned", UID: UID, Team: team, Name: create.UnitType}
+	if playCard {
+		spawned.HeldUID = create.UID
+	}
+	if err = emitMatchEvent(world, create.MatchID, spawned); err != nil {
+		return fmt.Errorf("(unit_spawner.go) - %w", err)
+	}
+	return nil
 }
 
-// Deals with the logic of playing a card from hand and drawing from deck to replace
-func handLogic(world cardinal.WorldContext, gameState types.EntityID, name, team string, cost, UID int) error {
+// SpawnUnit places a unit for team in a started match without taking a card or gold from the player
+func SpawnUnit(world cardinal.WorldContext, team string, create *msg.CreateUnitMsg) error {
+	gameState, err := getGameStateGSS(world, &comp.MatchId{MatchId: create.MatchID})
+	if err != nil {
+		return fmt.Errorf("(unit_spawner.go/SpawnUnit): %w", err)
+	}
+	matchMap, err := cardinal.GetComponent[comp.MapName](world, gameState)
+	if err != nil {
+		return fmt.Errorf("error getting map name component (unit_spawner.go/SpawnUnit): %w", err)
+	}
+	return spawnUnitUS(world, gameState, matchMap.MapName, team, create, false)
+}
+
+// Deals with the logic of playing a card from hand and drawing from deck to replace, returns the gold left
+func handLogic(world cardinal.WorldContext, gameState types.EntityID, name, team string, cost int) (float32, error) {
 
 	var found bool = false
 
@@ -149,11 +223,11 @@ func handLogic(world cardinal.WorldContext, gameState types.EntityID, name, team
 		//get player1 component from game state
 		player1, err := cardinal.GetComponent[comp.Player1](world, gameState)
 		if err != nil {
-			return fmt.Errorf("error getting player1 component (unit_spawner.go): %w", err)
+			return 0, fmt.Errorf("error getting player1 component (unit_spawner.go): %w", err)
 		}
 		//check if enough gold to spawn unit
 		if player1.Gold < float32(cost) {
-			return fmt.Errorf("not enough gold to spawn %s (unit_spawner.go): ", name)
+			return 0, fmt.Errorf("not enough gold to spawn %s (unit_spawner.go): ", name)
 		}
 		//check unit spawned is in hand
 		for _, v := range player1.Hand { //search hand
@@ -163,13 +237,11 @@ func handLogic(world cardinal.WorldContext, gameState types.EntityID, name, team
 			}
 		}
 		if !found {
-			return fmt.Errorf("card not in hand (unit_spawner.go) ")
+			return 0, fmt.Errorf("card not in hand (unit_spawner.go) ")
 		}
 
 		//reduce Gold
 		player1.Gold -= float32(cost)
-		//-1 key means to tell player to remove unit they were holding to transition it to this spawned unit
-		player1.RemovalList[UID] = true
 
 		//hand sorting
 		tempCard := player1.Deck[0]                     //get top deck card
@@ -178,22 +250,24 @@ func handLogic(world cardinal.WorldContext, gameState types.EntityID, name, team
 			if v == name { // if card == unit spawned
 				player1.Hand[i] = tempCard             //insert top card to hand
 				player1.Deck = append(player1.Deck, v) // put spawned unit to back of deck
+				break                                  // only play one copy if the hand holds duplicates
 			}
 		}
 
 		err = cardinal.SetComponent(world, gameState, player1)
 		if err != nil {
-			return fmt.Errorf("error setting player1 component (unit_spawner.go): %w", err)
+			return 0, fmt.Errorf("error setting player1 component (unit_spawner.go): %w", err)
 		}
+		return player1.Gold, nil
 	} else {
 		// get player2 component from game state
 		player2, err := cardinal.GetComponent[comp.Player2](world, gameState)
 		if err != nil {
-			return fmt.Errorf("error getting player2 component (unit_spawner.go): %w", err)
+			return 0, fmt.Errorf("error getting player2 component (unit_spawner.go): %w", err)
 		}
 		//check if enough gold to spawn unit
 		if player2.Gold < float32(cost) {
-			return fmt.Errorf("not enough gold to spawn %s (unit_spawner.go): ", name)
+			return 0, fmt.Errorf("not enough gold to spawn %s (unit_spawner.go): ", name)
 		}
 		//check unit spawned is in hand
 		for _, v := range player2.Hand { //search hand
@@ -203,13 +277,11 @@ func handLogic(world cardinal.WorldContext, gameState types.EntityID, name, team
 			}
 		}
 		if !found {
-			return fmt.Errorf("card not in hand (unit_spawner.go) ")
+			return 0, fmt.Errorf("card not in hand (unit_spawner.go) ")
 		}
 
 		//reduce Gold
 		player2.Gold -= float32(cost)
-		//-1 key means to tell player to remove unit they were holding to transition it to this spawned unit
-		player2.RemovalList[UID] = true
 
 		//hand sorting
 		tempCard := player2.Deck[0]                     //get top deck card
@@ -218,16 +290,16 @@ func handLogic(world cardinal.WorldContext, gameState types.EntityID, name, team
 			if v == name { // if card == unit spawned
 				player2.Hand[i] = tempCard             //insert top card to hand
 				player2.Deck = append(player2.Deck, v) // put spawned unit to back of deck
+				break                                  // only play one copy if the hand holds duplicates
 			}
 		}
 
 		err = cardinal.SetComponent(world, gameState, player2)
 		if err != nil {
-			return fmt.Errorf("error setting player2 component (unit_spawner.go): %w", err)
+			return 0, fmt.Errorf("error setting player2 component (unit_spawner.go): %w", err)
 		}
+		return player2.Gold, nil
 	}
-
-	return nil
 }
 
 func removeFirstElement(slice []string) []string {
@@ -0,0 +1,116 @@
+package system
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+	"MobaClashRoyal/msg"
+)
+
+// registers the hash of the sender's view key for the team they play for in the match. only the hash is ever in
+// state, a request is shown the team's view by sending the key itself. registering again replaces the old key
+func RegisterViewKeySystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(world,
+		func(register cardinal.TxData[msg.RegisterViewKeyMsg]) (msg.RegisterViewKeyResult, error) {
+			if !validKeyHash(register.Msg.KeyHash) {
+				return msg.RegisterViewKeyResult{Success: false}, fmt.Errorf("%w (view_key.go)", ErrBadKeyHash)
+			}
+			gameState, err := getGameStateGSS(world, &comp.MatchId{MatchId: register.Msg.MatchID})
+			if err != nil {
+				return msg.RegisterViewKeyResult{Success: false}, fmt.Errorf("match has not started (view_key.go): %w", ErrMatchNotFound)
+			}
+			//the team comes from the signed persona, never from the message
+			team, err := authorizePlayer(world, gameState, register.Tx.PersonaTag)
+			if err != nil {
+				return msg.RegisterViewKeyResult{Success: false}, fmt.Errorf("(view_key.go): %w", err)
+			}
+
+			keysID, keys, err := getViewKeys(world, register.Msg.MatchID)
+			if err != nil {
+				return msg.RegisterViewKeyResult{Success: false}, err
+			}
+			if keysID == iterators.BadID {
+				_, err = cardinal.Create(world,
+					comp.MatchId{MatchId: register.Msg.MatchID},
+					comp.ViewKeys{Teams: map[string]string{team: register.Msg.KeyHash}})
+				if err != nil {
+					return msg.RegisterViewKeyResult{Success: false}, fmt.Errorf("error creating view keys (view_key.go): %w", err)
+				}
+				return msg.RegisterViewKeyResult{Success: true, Team: team}, nil
+			}
+
+			keys.Teams[team] = register.Msg.KeyHash
+			if err := cardinal.SetComponent(world, keysID, keys); err != nil {
+				return msg.RegisterViewKeyResult{Success: false}, fmt.Errorf("error setting view keys (view_key.go): %w", err)
+			}
+			return msg.RegisterViewKeyResult{Success: true, Team: team}, nil
+		})
+}
+
+// ViewerTeam returns the team whose registered view key the request sent, queries use it instead of a team or
+// persona from the unsigned request body
+func ViewerTeam(world cardinal.WorldContext, matchID, viewKey string) (string, error) {
+	keysID, keys, err := getViewKeys(world, matchID)
+	if err != nil {
+		return "", err
+	}
+	if keysID == iterators.BadID {
+		return "", fmt.Errorf("%w (ViewerTeam): %s has no registered view keys", ErrBadViewKey, matchID)
+	}
+	team := viewKeyTeam(keys, viewKey)
+	if team == "" {
+		return "", fmt.Errorf("%w (ViewerTeam): %s", ErrBadViewKey, matchID)
+	}
+	return team, nil
+}
+
+// team the view key was registered for, empty if it matches none
+func viewKeyTeam(keys *comp.ViewKeys, viewKey string) string {
+	if viewKey == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(viewKey))
+	hash := hex.EncodeToString(sum[:])
+	for _, team := range visionTeams {
+		registered, ok := keys.Teams[team]
+		if ok && subtle.ConstantTimeCompare([]byte(registered), []byte(hash)) == 1 {
+			return team
+		}
+	}
+	return ""
+}
+
+// true for a lowercase hex sha256, the form viewKeyTeam compares against
+func validKeyHash(hash string) bool {
+	raw, err := hex.DecodeString(hash)
+	return err == nil && len(raw) == sha256.Size && hex.EncodeToString(raw) == hash
+}
+
+// view keys entity of a match, iterators.BadID if no player registered one yet
+func getViewKeys(world cardinal.WorldContext, matchID string) (types.EntityID, *comp.ViewKeys, error) {
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == matchID
+	})
+	keysID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.ViewKeys]())).
+		Where(matchFilter).First(world)
+	if err != nil {
+		return keysID, nil, fmt.Errorf("error searching for view keys (getViewKeys): %w", err)
+	}
+	if keysID == iterators.BadID {
+		return keysID, nil, nil
+	}
+	keys, err := cardinal.GetComponent[comp.ViewKeys](world, keysID)
+	if err != nil {
+		return keysID, nil, fmt.Errorf("error getting view keys component (getViewKeys): %w", err)
+	}
+	return keysID, keys, nil
+}
@@ -0,0 +1,65 @@
+package system
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func testKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestViewKeyTeam(t *testing.T) {
+	keys := &comp.ViewKeys{Teams: map[string]string{
+		"Blue": testKeyHash("blue-secret"),
+		"Red":  testKeyHash("red-secret"),
+	}}
+	tests := []struct {
+		name    string
+		keys    *comp.ViewKeys
+		viewKey string
+		want    string
+	}{
+		{"blue key", keys, "blue-secret", "Blue"},
+		{"red key", keys, "red-secret", "Red"},
+		{"unknown key", keys, "guess", ""},
+		{"empty key", keys, "", ""},
+		{"the hash is not the key", keys, testKeyHash("blue-secret"), ""},
+		{"team name is not a key", keys, "Red", ""},
+		{"only blue registered", &comp.ViewKeys{Teams: map[string]string{"Blue": testKeyHash("blue-secret")}}, "red-secret", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := viewKeyTeam(tt.keys, tt.viewKey); got != tt.want {
+				t.Errorf("viewKeyTeam(%q) = %q, want %q", tt.viewKey, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidKeyHash(t *testing.T) {
+	tests := []struct {
+		name string
+		hash string
+		want bool
+	}{
+		{"sha256 hex", testKeyHash("key"), true},
+		{"empty", "", false},
+		{"too short", testKeyHash("key")[:62], false},
+		{"too long", testKeyHash("key") + "00", false},
+		{"not hex", strings.Repeat("z", 64), false},
+		{"uppercase never matches a query", strings.ToUpper(testKeyHash("key")), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validKeyHash(tt.hash); got != tt.want {
+				t.Errorf("validKeyHash(%q) = %v, want %v", tt.hash, got, tt.want)
+			}
+		})
+	}
+}
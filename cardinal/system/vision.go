@@ -0,0 +1,263 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/iterators"
+	"pkg.world.dev/world-engine/cardinal/search/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "MobaClashRoyal/component"
+)
+
+// ticks an enemy unit stays at its last known position after leaving sight (100ms tickrate, 100 = 10 seconds)
+var LastKnownTicks uint64 = 100
+
+// teams that get their own view of a match
+var visionTeams = []string{"Blue", "Red"}
+
+type sightCircle struct {
+	x, y   float32
+	radius int
+}
+
+// creates the vision of a new match, nothing of the enemy is seen yet
+func createVision(world cardinal.WorldContext, matchID string) error {
+	teams := make(map[string]comp.TeamVision)
+	for _, team := range visionTeams {
+		teams[team] = comp.TeamVision{
+			Visible:     make(map[int]bool),
+			LastKnown:   make(map[int]comp.LastKnownUnit),
+			ChangedTick: make(map[int]uint64),
+		}
+	}
+	_, err := cardinal.Create(world, comp.MatchId{MatchId: matchID}, comp.Vision{Teams: teams})
+	if err != nil {
+		return fmt.Errorf("error creating vision (vision.go/createVision): %w", err)
+	}
+	return nil
+}
+
+// vision of a match, empty if the match has none
+func getVision(world cardinal.WorldContext, matchID string) (*comp.Vision, error) {
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == matchID
+	})
+	visionID, err := cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.Vision]())).
+		Where(matchFilter).First(world)
+	if err != nil {
+		return nil, fmt.Errorf("error searching for vision (vision.go/getVision): %w", err)
+	}
+	if visionID == iterators.BadID {
+		return &comp.Vision{}, nil
+	}
+	vision, err := cardinal.GetComponent[comp.Vision](world, visionID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vision (vision.go/getVision): %w", err)
+	}
+	return vision, nil
+}
+
+// works out what each team sees after the tick. enemy units overlapping the sight radius of one of the team's units or
+// structures are found through the spatial hash, enemy projectiles and special powers are seen when inside it
+func VisionSystem(world cardinal.WorldContext) error {
+	//ended matches keep what was seen last
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
+
+	return cardinal.NewSearch().Entity(
+		filter.Exact(filter.Component[comp.MatchId](), filter.Component[comp.Vision]())).
+		Where(activeFilter).
+		Each(world, func(id types.EntityID) bool {
+			matchID, vision, err := GetComponents2[comp.MatchId, comp.Vision](world, id)
+			if err != nil {
+				fmt.Printf("error getting vision components (vision.go): %v \n", err)
+				return false
+			}
+			if err := updateVision(world, matchID.MatchId, vision); err != nil {
+				fmt.Printf("error updating vision of %s (vision.go): %v \n", matchID.MatchId, err)
+				return true
+			}
+			if err := cardinal.SetComponent(world, id, vision); err != nil {
+				fmt.Printf("error setting vision (vision.go): %v \n", err)
+				return false
+			}
+			return true
+		})
+}
+
+// finds the enemies each team has in sight this tick and updates its view of the match
+func updateVision(world cardinal.WorldContext, matchID string, vision *comp.Vision) error {
+	gameState, err := getGameStateGSS(world, &comp.MatchId{MatchId: matchID})
+	if err != nil {
+		return err
+	}
+	hash, err := cardinal.GetComponent[comp.SpatialHash](world, gameState)
+	if err != nil {
+		return fmt.Errorf("error getting spatial hash (vision.go/updateVision): %w", err)
+	}
+
+	//sight of every unit and structure, objects spanning several cells are in the hash once per cell
+	circles := make(map[string][]sightCircle)
+	counted := make(map[types.EntityID]bool)
+	for _, cell := range hash.Cells {
+		for i, entityID := range cell.UnitIDs {
+			if counted[entityID] {
+				continue
+			}
+			counted[entityID] = true
+			sight, err := cardinal.GetComponent[comp.SightRadius](world, entityID)
+			if err != nil {
+				continue //spawned before sight existed, it sees nothing
+			}
+			circles[cell.Team[i]] = append(circles[cell.Team[i]], sightCircle{x: cell.PositionsX[i], y: cell.PositionsY[i], radius: sight.SightRadius})
+		}
+	}
+
+	//enemy units in sight with how they look now, structures are always shown so they are not tracked
+	sighted := make(map[string]map[int]*comp.LastKnownUnit)
+	present := make(map[int]bool)
+	for _, team := range visionTeams {
+		sighted[team] = make(map[int]*comp.LastKnownUnit)
+		checked := make(map[types.EntityID]bool)
+		for _, circle := range circles[team] {
+			for _, enemyID := range FindEnemiesInRadiusSpatialHash(hash, circle.x, circle.y, circle.radius, team) {
+				if checked[enemyID] {
+					continue
+				}
+				checked[enemyID] = true
+				if _, err := cardinal.GetComponent[comp.UnitTag](world, enemyID); err != nil {
+					continue
+				}
+				uid, unit, err := lastKnownUnit(world, enemyID)
+				if err != nil {
+					return err
+				}
+				sighted[team][uid] = unit
+			}
+		}
+	}
+
+	//units still alive, a unit that was in sight and is gone was seen dying
+	matchFilter := cardinal.ComponentFilter(func(m comp.MatchId) bool {
+		return m.MatchId == matchID
+	})
+	err = cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.UnitTag]())).
+		Where(matchFilter).
+		Each(world, func(id types.EntityID) bool {
+			uid, err := cardinal.GetComponent[comp.UID](world, id)
+			if err != nil {
+				fmt.Printf("error getting unit uid (vision.go/updateVision): %v \n", err)
+				return false
+			}
+			present[uid.UID] = true
+			return true
+		})
+	if err != nil {
+		return fmt.Errorf("error searching units (vision.go/updateVision): %w", err)
+	}
+
+	//enemy projectiles and special powers inside the team's sight
+	err = cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[comp.Owner]())).
+		Where(matchFilter).
+		Each(world, func(id types.EntityID) bool {
+			uid, owner, pos, err := GetComponents3[comp.UID, comp.Owner, comp.Position](world, id)
+			if err != nil {
+				fmt.Printf("error getting projectile components (vision.go/updateVision): %v \n", err)
+				return false
+			}
+			for _, team := range visionTeams {
+				if team != owner.Team && inSight(circles[team], pos.PositionVectorX, pos.PositionVectorY) {
+					sighted[team][uid.UID] = nil
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return fmt.Errorf("error searching projectiles (vision.go/updateVision): %w", err)
+	}
+
+	tick := world.CurrentTick()
+	for _, team := range visionTeams {
+		teamVision := vision.Teams[team]
+		applyVision(&teamVision, sighted[team], present, tick)
+		vision.Teams[team] = teamVision
+	}
+	return nil
+}
+
+// moves a team's view to what it sees this tick. units that left sight stay where they were last seen for
+// LastKnownTicks unless the team saw them die
+func applyVision(teamVision *comp.TeamVision, sighted map[int]*comp.LastKnownUnit, present map[int]bool, tick uint64) {
+	for uid, unit := range sighted {
+		if !teamVision.Visible[uid] {
+			teamVision.ChangedTick[uid] = tick
+		}
+		if unit != nil {
+			unit.Tick = tick
+			teamVision.LastKnown[uid] = *unit
+		}
+	}
+
+	for uid := range teamVision.Visible {
+		if _, ok := sighted[uid]; ok {
+			continue
+		}
+		teamVision.ChangedTick[uid] = tick
+		if !present[uid] {
+			delete(teamVision.LastKnown, uid)
+		}
+	}
+
+	for uid, unit := range teamVision.LastKnown {
+		if _, ok := sighted[uid]; !ok && tick-unit.Tick >= LastKnownTicks {
+			delete(teamVision.LastKnown, uid)
+			teamVision.ChangedTick[uid] = tick
+		}
+	}
+
+	teamVision.Visible = make(map[int]bool, len(sighted))
+	for uid := range sighted {
+		teamVision.Visible[uid] = true
+	}
+
+	//forget uids that left the team's view longer ago than deltas reach back
+	for uid, changed := range teamVision.ChangedTick {
+		_, known := teamVision.LastKnown[uid]
+		if !teamVision.Visible[uid] && !known && changed+ReplicationHistoryTicks < tick {
+			delete(teamVision.ChangedTick, uid)
+		}
+	}
+}
+
+// uid and current look of an enemy unit in sight
+func lastKnownUnit(world cardinal.WorldContext, id types.EntityID) (int, *comp.LastKnownUnit, error) {
+	uid, name, team, health, pos, err := GetComponents5[comp.UID, comp.UnitName, comp.Team, comp.Health, comp.Position](world, id)
+	if err != nil {
+		return 0, nil, fmt.Errorf("(vision.go/lastKnownUnit): %w", err)
+	}
+	return uid.UID, &comp.LastKnownUnit{
+		UnitName:  name.UnitName,
+		Team:      team.Team,
+		CurrentHP: health.CurrentHP,
+		MaxHP:     health.MaxHP,
+		Position:  *pos,
+	}, nil
+}
+
+// true if the point is inside one of the sight circles
+func inSight(circles []sightCircle, x, y float32) bool {
+	for _, circle := range circles {
+		radius := float32(circle.radius)
+		if (x-circle.x)*(x-circle.x)+(y-circle.y)*(y-circle.y) <= radius*radius {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package system
+
+import (
+	"testing"
+
+	comp "MobaClashRoyal/component"
+)
+
+func TestInSight(t *testing.T) {
+	circles := []sightCircle{{x: 0, y: 0, radius: 100}, {x: 1000, y: 0, radius: 50}}
+	tests := []struct {
+		name string
+		x, y float32
+		want bool
+	}{
+		{"center", 0, 0, true},
+		{"on the edge", 0, 100, true},
+		{"just outside", 71, 71, false},
+		{"inside the second circle", 1030, 30, true},
+		{"between the circles", 500, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inSight(circles, tt.x, tt.y); got != tt.want {
+				t.Errorf("inSight(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+	if inSight(nil, 0, 0) {
+		t.Errorf("inSight() without circles = true, want false")
+	}
+}
+
+func TestApplyVision(t *testing.T) {
+	const uid = 7
+	seen := func() map[int]*comp.LastKnownUnit {
+		return map[int]*comp.LastKnownUnit{uid: {UnitName: "Vampire", Team: "Red", CurrentHP: 40}}
+	}
+	alive := map[int]bool{uid: true}
+	gone := map[int]bool{}
+
+	type step struct {
+		tick        uint64
+		sighted     map[int]*comp.LastKnownUnit
+		present     map[int]bool
+		wantVisible bool
+		wantKnown   bool
+		wantChanged uint64 //0 when the uid should have no changed tick
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "comes into sight",
+			steps: []step{
+				{10, seen(), alive, true, true, 10},
+				{11, seen(), alive, true, true, 10},
+			},
+		},
+		{
+			name: "last known position is kept for LastKnownTicks",
+			steps: []step{
+				{10, seen(), alive, true, true, 10},
+				{11, nil, alive, false, true, 11},
+				{10 + LastKnownTicks - 1, nil, alive, false, true, 11},
+				{10 + LastKnownTicks, nil, alive, false, false, 10 + LastKnownTicks},
+			},
+		},
+		{
+			name: "seen dying is forgotten at once",
+			steps: []step{
+				{10, seen(), alive, true, true, 10},
+				{11, nil, gone, false, false, 11},
+			},
+		},
+		{
+			name: "dying out of sight is not learned",
+			steps: []step{
+				{10, seen(), alive, true, true, 10},
+				{11, nil, alive, false, true, 11},
+				{12, nil, gone, false, true, 11},
+				{10 + LastKnownTicks, nil, gone, false, false, 10 + LastKnownTicks},
+			},
+		},
+		{
+			name: "seen again refreshes the last known tick",
+			steps: []step{
+				{10, seen(), alive, true, true, 10},
+				{11, nil, alive, false, true, 11},
+				{50, seen(), alive, true, true, 50},
+				{51, nil, alive, false, true, 51},
+				{10 + LastKnownTicks, nil, alive, false, true, 51},
+				{50 + LastKnownTicks, nil, alive, false, false, 50 + LastKnownTicks},
+			},
+		},
+		{
+			name: "projectiles are seen but have no last known position",
+			steps: []step{
+				{10, map[int]*comp.LastKnownUnit{uid: nil}, alive, true, false, 10},
+				{11, nil, alive, false, false, 11},
+			},
+		},
+		{
+			name: "changed tick is dropped once deltas can't reach it",
+			steps: []step{
+				{10, seen(), alive, true, true, 10},
+				{11, nil, gone, false, false, 11},
+				{11 + ReplicationHistoryTicks, nil, gone, false, false, 11},
+				{12 + ReplicationHistoryTicks, nil, gone, false, false, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vision := comp.TeamVision{
+				Visible:     make(map[int]bool),
+				LastKnown:   make(map[int]comp.LastKnownUnit),
+				ChangedTick: make(map[int]uint64),
+			}
+			for _, s := range tt.steps {
+				applyVision(&vision, s.sighted, s.present, s.tick)
+				if vision.Visible[uid] != s.wantVisible {
+					t.Errorf("tick %d: visible = %v, want %v", s.tick, vision.Visible[uid], s.wantVisible)
+				}
+				if _, known := vision.LastKnown[uid]; known != s.wantKnown {
+					t.Errorf("tick %d: last known = %v, want %v", s.tick, known, s.wantKnown)
+				}
+				if changed := vision.ChangedTick[uid]; changed != s.wantChanged {
+					t.Errorf("tick %d: changed tick = %d, want %d", s.tick, changed, s.wantChanged)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package system
 import (
 	comp "MobaClashRoyal/component"
 	"fmt"
+	"sort"
 
 	"pkg.world.dev/world-engine/cardinal"
 	"pkg.world.dev/world-engine/cardinal/search/filter"
@@ -15,10 +16,18 @@ func WinCondition(world cardinal.WorldContext) error {
 	healthFilter := cardinal.ComponentFilter(func(m comp.UnitName) bool {
 		return m.UnitName == "Base"
 	})
+	//skip matches that have ended
+	activeFilter, err := activeMatchFilter(world)
+	if err != nil {
+		return err
+	}
+
+	//teams whose base fell this tick per match
+	fallen := make(map[string][]string)
 	//check all structures with no health
-	err := cardinal.NewSearch().Entity(
+	err = cardinal.NewSearch().Entity(
 		filter.Contains(filter.Component[comp.StructureTag]())).
-		Where(healthFilter).Each(world, func(id types.EntityID) bool {
+		Where(cardinal.AndFilter(healthFilter, activeFilter)).Each(world, func(id types.EntityID) bool {
 
 		//get structure Health
 		health, err := cardinal.GetComponent[comp.Health](world, id)
@@ -29,18 +38,52 @@ func WinCondition(world cardinal.WorldContext) error {
 
 		//check if base has died
 		if health.CurrentHP <= 0 {
-			//get structure matchID
-			matchID, err := cardinal.GetComponent[comp.MatchId](world, id)
+			//get structure matchID and team
+			matchID, team, err := GetComponents2[comp.MatchId, comp.Team](world, id)
 			if err != nil {
-				fmt.Printf("error getting matchID component (win condition): %s\n", err)
+				fmt.Printf("error getting base components (win condition): %s\n", err)
 				return false
 			}
-			//remove all entites
-			RemoveAllEntitiesSystem(world, matchID.MatchId)
+			fallen[matchID.MatchId] = append(fallen[matchID.MatchId], team.Team)
 		}
 
 		return true
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	//end matches after the search so the result entities aren't created mid iteration, in id order so entity ids stay deterministic
+	matchIDs := make([]string, 0, len(fallen))
+	for matchID := range fallen {
+		matchIDs = append(matchIDs, matchID)
+	}
+	sort.Strings(matchIDs)
+	for _, matchID := range matchIDs {
+		ending := baseKillEnding(matchID, fallen[matchID])
+		if err := endMatch(world, ending.matchID, ending.winner, ending.reason); err != nil {
+			fmt.Printf("(win condition): %s\n", err)
+		}
+	}
+	return nil
+}
+
+// the team whose base fell loses, both bases falling on the same tick is a draw
+func baseKillEnding(matchID string, fallenTeams []string) matchEnding {
+	blueFell, redFell := false, false
+	for _, team := range fallenTeams {
+		if team == "Blue" {
+			blueFell = true
+		} else {
+			redFell = true
+		}
+	}
+	switch {
+	case blueFell && redFell:
+		return matchEnding{matchID, "", "baseDestroyed"}
+	case blueFell:
+		return matchEnding{matchID, "Red", "baseDestroyed"}
+	default:
+		return matchEnding{matchID, "Blue", "baseDestroyed"}
+	}
 }
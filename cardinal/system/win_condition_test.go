@@ -0,0 +1,23 @@
+package system
+
+import "testing"
+
+func TestBaseKillEnding(t *testing.T) {
+	tests := []struct {
+		name   string
+		fallen []string
+		want   matchEnding
+	}{
+		{"blue base fell", []string{"Blue"}, matchEnding{"m1", "Red", "baseDestroyed"}},
+		{"red base fell", []string{"Red"}, matchEnding{"m1", "Blue", "baseDestroyed"}},
+		{"both fell", []string{"Blue", "Red"}, matchEnding{"m1", "", "baseDestroyed"}},
+		{"both fell in the other order", []string{"Red", "Blue"}, matchEnding{"m1", "", "baseDestroyed"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := baseKillEnding("m1", tt.fallen); got != tt.want {
+				t.Errorf("baseKillEnding(%v) = %+v, want %+v", tt.fallen, got, tt.want)
+			}
+		})
+	}
+}